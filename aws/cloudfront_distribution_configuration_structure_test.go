@@ -16,6 +16,7 @@ func defaultCacheBehaviorConf() map[string]interface{} {
 		"target_origin_id":            "myS3Origin",
 		"forwarded_values":            []interface{}{forwardedValuesConf()},
 		"min_ttl":                     0,
+		"trusted_key_groups":          trustedKeyGroupsConf(),
 		"trusted_signers":             trustedSignersConf(),
 		"lambda_function_association": lambdaFunctionAssociationsConf(),
 		"max_ttl":                     31536000,
@@ -30,6 +31,10 @@ func defaultCacheBehaviorConf() map[string]interface{} {
 	}
 }
 
+func trustedKeyGroupsConf() []interface{} {
+	return []interface{}{"key_group_1", "key_group_2"}
+}
+
 func trustedSignersConf() []interface{} {
 	return []interface{}{"1234567890EX", "1234567891EX"}
 }
@@ -289,6 +294,9 @@ func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior(t *testing.T)
 	if *dcb.MinTTL != 0 {
 		t.Fatalf("Expected MinTTL to be 0, got %v", *dcb.MinTTL)
 	}
+	if !reflect.DeepEqual(dcb.TrustedKeyGroups.Items, expandStringList(trustedKeyGroupsConf())) {
+		t.Fatalf("Expected TrustedKeyGroups.Items to be %v, got %v", trustedKeyGroupsConf(), dcb.TrustedKeyGroups.Items)
+	}
 	if !reflect.DeepEqual(dcb.TrustedSigners.Items, expandStringList(trustedSignersConf())) {
 		t.Fatalf("Expected TrustedSigners.Items to be %v, got %v", trustedSignersConf(), dcb.TrustedSigners.Items)
 	}
@@ -312,6 +320,44 @@ func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior(t *testing.T)
 	}
 }
 
+func TestCloudFrontStructure_expandTrustedKeyGroups(t *testing.T) {
+	data := trustedKeyGroupsConf()
+	tkg := expandTrustedKeyGroups(data)
+	if *tkg.Quantity != 2 {
+		t.Fatalf("Expected Quantity to be 2, got %v", *tkg.Quantity)
+	}
+	if !*tkg.Enabled {
+		t.Fatalf("Expected Enabled to be true, got %v", *tkg.Enabled)
+	}
+	if !reflect.DeepEqual(tkg.Items, expandStringList(data)) {
+		t.Fatalf("Expected Items to be %v, got %v", data, tkg.Items)
+	}
+}
+
+func TestCloudFrontStructure_flattenTrustedKeyGroups(t *testing.T) {
+	in := trustedKeyGroupsConf()
+	tkg := expandTrustedKeyGroups(in)
+	out := flattenTrustedKeyGroups(tkg)
+
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Expected out to be %v, got %v", in, out)
+	}
+}
+
+func TestCloudFrontStructure_expandTrustedKeyGroups_empty(t *testing.T) {
+	data := []interface{}{}
+	tkg := expandTrustedKeyGroups(data)
+	if *tkg.Quantity != 0 {
+		t.Fatalf("Expected Quantity to be 0, got %v", *tkg.Quantity)
+	}
+	if *tkg.Enabled {
+		t.Fatalf("Expected Enabled to be false, got %v", *tkg.Enabled)
+	}
+	if tkg.Items != nil {
+		t.Fatalf("Expected Items to be nil, got %v", tkg.Items)
+	}
+}
+
 func TestCloudFrontStructure_expandTrustedSigners(t *testing.T) {
 	data := trustedSignersConf()
 	ts := expandTrustedSigners(data)
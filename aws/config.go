@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/applicationinsights"
 	"github.com/aws/aws-sdk-go/service/appmesh"
@@ -188,6 +189,10 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	// AssumeRoleChain lists the roles assumed, in order, before AssumeRoleARN.
+	AssumeRoleChain           []*AssumeRole
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
@@ -202,6 +207,8 @@ type Config struct {
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 
+	TagPolicyCompliance string
+
 	terraformVersion string
 }
 
@@ -215,6 +222,7 @@ type AWSClient struct {
 	apigatewayv2conn                    *apigatewayv2.ApiGatewayV2
 	appautoscalingconn                  *applicationautoscaling.ApplicationAutoScaling
 	applicationinsightsconn             *applicationinsights.ApplicationInsights
+	appconfigconn                       *appconfig.AppConfig
 	appmeshconn                         *appmesh.AppMesh
 	appstreamconn                       *appstream.AppStream
 	appsyncconn                         *appsync.AppSync
@@ -361,6 +369,8 @@ type AWSClient struct {
 	supportedplatforms                  []string
 	swfconn                             *swf.SWF
 	syntheticsconn                      *synthetics.Synthetics
+	TagPolicy                           keyvaluetags.TagPolicy
+	TagPolicyCompliance                 string
 	terraformVersion                    string
 	timestreamwriteconn                 *timestreamwrite.TimestreamWrite
 	transferconn                        *transfer.Transfer
@@ -430,6 +440,10 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	if err := c.resolveChainedCredentials(awsbaseConfig); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
@@ -458,6 +472,7 @@ func (c *Config) Client() (interface{}, error) {
 		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
 		appautoscalingconn:                  applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationautoscaling"])})),
 		applicationinsightsconn:             applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationinsights"])})),
+		appconfigconn:                       appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appconfig"])})),
 		appmeshconn:                         appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appmesh"])})),
 		appstreamconn:                       appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appstream"])})),
 		appsyncconn:                         appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appsync"])})),
@@ -597,6 +612,7 @@ func (c *Config) Client() (interface{}, error) {
 		stsconn:                             sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})),
 		swfconn:                             swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["swf"])})),
 		syntheticsconn:                      synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["synthetics"])})),
+		TagPolicyCompliance:                 c.TagPolicyCompliance,
 		terraformVersion:                    c.terraformVersion,
 		timestreamwriteconn:                 timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["timestreamwrite"])})),
 		transferconn:                        transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])})),
@@ -804,6 +820,16 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	if c.TagPolicyCompliance != "" && c.TagPolicyCompliance != tagPolicyComplianceDisabled {
+		tagPolicy, err := getEffectiveTagPolicy(client.organizationsconn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		client.TagPolicy = tagPolicy
+	}
+
 	return client, nil
 }
 
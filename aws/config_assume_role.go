@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// AssumeRole describes a single role assumption hop.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// AssumeRoleWithWebIdentity describes a role assumption using an OpenID Connect token.
+type AssumeRoleWithWebIdentity struct {
+	DurationSeconds      int
+	Policy               string
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// resolveChainedCredentials exchanges any web identity token and assumes any chained roles,
+// replacing the base credentials in the awsbase configuration with the resulting temporary credentials.
+// The final assume_role hop is left for awsbase to perform and validate.
+func (c *Config) resolveChainedCredentials(awsbaseConfig *awsbase.Config) error {
+	if c.AssumeRoleWithWebIdentity != nil {
+		value, err := c.assumeRoleWithWebIdentity(awsbaseConfig)
+
+		if err != nil {
+			return fmt.Errorf("error assuming role (%s) with web identity: %w", c.AssumeRoleWithWebIdentity.RoleARN, err)
+		}
+
+		setAwsbaseConfigCredentials(awsbaseConfig, value)
+	}
+
+	for i, assumeRole := range c.AssumeRoleChain {
+		if assumeRole == nil {
+			continue
+		}
+
+		hopConfig := *awsbaseConfig
+		hopConfig.AssumeRoleARN = assumeRole.RoleARN
+		hopConfig.AssumeRoleDurationSeconds = assumeRole.DurationSeconds
+		hopConfig.AssumeRoleExternalID = assumeRole.ExternalID
+		hopConfig.AssumeRolePolicy = assumeRole.Policy
+		hopConfig.AssumeRolePolicyARNs = assumeRole.PolicyARNs
+		hopConfig.AssumeRoleSessionName = assumeRole.SessionName
+		hopConfig.AssumeRoleTags = assumeRole.Tags
+		hopConfig.AssumeRoleTransitiveTagKeys = assumeRole.TransitiveTagKeys
+
+		log.Printf("[INFO] Assuming chained role %d of %d: %s", i+1, len(c.AssumeRoleChain)+1, assumeRole.RoleARN)
+		creds, err := awsbase.GetCredentials(&hopConfig)
+
+		if err != nil {
+			return fmt.Errorf("error assuming chained role %d (%s): %w", i+1, assumeRole.RoleARN, err)
+		}
+
+		value, err := creds.Get()
+
+		if err != nil {
+			return fmt.Errorf("error retrieving credentials for chained role %d (%s): %w", i+1, assumeRole.RoleARN, err)
+		}
+
+		setAwsbaseConfigCredentials(awsbaseConfig, value)
+	}
+
+	return nil
+}
+
+func (c *Config) assumeRoleWithWebIdentity(awsbaseConfig *awsbase.Config) (credentials.Value, error) {
+	webIdentity := c.AssumeRoleWithWebIdentity
+
+	token, err := webIdentity.token()
+
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.AnonymousCredentials,
+		EndpointResolver: awsbaseConfig.EndpointResolver(),
+		HTTPClient:       cleanhttp.DefaultClient(),
+		MaxRetries:       aws.Int(awsbaseConfig.MaxRetries),
+		Region:           aws.String(awsbaseConfig.Region),
+	})
+
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("error creating session: %w", err)
+	}
+
+	// Match the AWS SDK default of a unique session name when none is given.
+	sessionName := webIdentity.SessionName
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(webIdentity.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(token),
+	}
+
+	if webIdentity.DurationSeconds > 0 {
+		input.DurationSeconds = aws.Int64(int64(webIdentity.DurationSeconds))
+	}
+
+	if webIdentity.Policy != "" {
+		input.Policy = aws.String(webIdentity.Policy)
+	}
+
+	for _, policyARN := range webIdentity.PolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", webIdentity.RoleARN, sessionName)
+	output, err := sts.New(sess).AssumeRoleWithWebIdentity(input)
+
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	if output == nil || output.Credentials == nil {
+		return credentials.Value{}, fmt.Errorf("empty credentials in response")
+	}
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    "AssumeRoleWithWebIdentity",
+	}, nil
+}
+
+func (w *AssumeRoleWithWebIdentity) token() (string, error) {
+	if w.WebIdentityToken != "" {
+		return w.WebIdentityToken, nil
+	}
+
+	if w.WebIdentityTokenFile == "" {
+		return "", fmt.Errorf("one of web_identity_token or web_identity_token_file must be set")
+	}
+
+	b, err := ioutil.ReadFile(w.WebIdentityTokenFile)
+
+	if err != nil {
+		return "", fmt.Errorf("error reading web identity token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+
+	if token == "" {
+		return "", fmt.Errorf("web identity token file (%s) is empty", w.WebIdentityTokenFile)
+	}
+
+	return token, nil
+}
+
+// setAwsbaseConfigCredentials makes the specified credentials the base credentials for awsbase.
+func setAwsbaseConfigCredentials(awsbaseConfig *awsbase.Config, value credentials.Value) {
+	awsbaseConfig.AccessKey = value.AccessKeyID
+	awsbaseConfig.SecretKey = value.SecretAccessKey
+	awsbaseConfig.Token = value.SessionToken
+	awsbaseConfig.Profile = ""
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestAssumeRoleWithWebIdentityToken(t *testing.T) {
+	tokenFile, err := ioutil.TempFile("", "tf-acc-test-web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+
+	if _, err := tokenFile.WriteString("file-token\n"); err != nil {
+		t.Fatal(err)
+	}
+	tokenFile.Close()
+
+	emptyTokenFile, err := ioutil.TempFile("", "tf-acc-test-web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(emptyTokenFile.Name())
+	emptyTokenFile.Close()
+
+	testCases := []struct {
+		Name          string
+		WebIdentity   *AssumeRoleWithWebIdentity
+		ExpectedToken string
+		ExpectError   bool
+	}{
+		{
+			Name:        "no token",
+			WebIdentity: &AssumeRoleWithWebIdentity{},
+			ExpectError: true,
+		},
+		{
+			Name: "token",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				WebIdentityToken: "inline-token",
+			},
+			ExpectedToken: "inline-token",
+		},
+		{
+			Name: "token file",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				WebIdentityTokenFile: tokenFile.Name(),
+			},
+			ExpectedToken: "file-token",
+		},
+		{
+			Name: "empty token file",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				WebIdentityTokenFile: emptyTokenFile.Name(),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "missing token file",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				WebIdentityTokenFile: tokenFile.Name() + "-missing",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.WebIdentity.token()
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedToken {
+				t.Errorf("got %q, expected %q", got, testCase.ExpectedToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2HostRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"filter": ec2CustomFiltersSchema(),
+
+			"host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"host_recovery": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instance_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sockets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+
+			"total_vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeHostsInput{}
+
+	if v, ok := d.GetOk("host_id"); ok {
+		input.HostIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filter = buildEC2CustomFilterList(d.Get("filter").(*schema.Set))
+
+	if tags, tagsOk := d.GetOk("tags"); tagsOk {
+		input.Filter = append(input.Filter, buildEC2TagFilterList(
+			keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
+		)...)
+	}
+
+	if len(input.Filter) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filter = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Hosts: %s", input)
+	var hosts []*ec2.Host
+
+	err := conn.DescribeHostsPages(input, func(page *ec2.DescribeHostsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		hosts = append(hosts, page.Hosts...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Hosts: %w", err)
+	}
+
+	if len(hosts) == 0 || hosts[0] == nil {
+		return fmt.Errorf("no matching EC2 Host found")
+	}
+
+	if len(hosts) > 1 {
+		return fmt.Errorf("multiple EC2 Hosts matched; use additional constraints to reduce matches to a single EC2 Host")
+	}
+
+	host := hosts[0]
+
+	d.SetId(aws.StringValue(host.HostId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: aws.StringValue(host.OwnerId),
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_id", host.HostId)
+	d.Set("host_recovery", host.HostRecovery)
+	d.Set("owner_id", host.OwnerId)
+	d.Set("state", host.State)
+
+	if v := host.HostProperties; v != nil {
+		d.Set("cores", v.Cores)
+		d.Set("instance_family", v.InstanceFamily)
+		d.Set("instance_type", v.InstanceType)
+		d.Set("sockets", v.Sockets)
+		d.Set("total_vcpus", v.TotalVCpus)
+	}
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(host.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
+)
+
+func dataSourceAwsEksAddonVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEksAddonVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"kubernetes_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEksAddonVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	addonName := d.Get("addon_name").(string)
+	kubernetesVersion := d.Get("kubernetes_version").(string)
+	mostRecent := d.Get("most_recent").(bool)
+
+	versionInfo, err := finder.AddonVersionByAddonNameAndKubernetesVersion(conn, addonName, kubernetesVersion, mostRecent)
+
+	if err != nil {
+		return fmt.Errorf("error reading EKS Add-On version info (%s, %s): %w", addonName, kubernetesVersion, err)
+	}
+
+	d.SetId(addonName)
+	d.Set("addon_name", addonName)
+	d.Set("kubernetes_version", kubernetesVersion)
+	d.Set("most_recent", mostRecent)
+	d.Set("version", aws.StringValue(versionInfo.AddonVersion))
+
+	return nil
+}
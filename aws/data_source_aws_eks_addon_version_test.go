@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSEksAddonVersionDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	latestDataSourceName := "data.aws_eks_addon_version.latest"
+	defaultDataSourceName := "data.aws_eks_addon_version.default"
+	addonResourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonVersionDataSourceConfig(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(latestDataSourceName, "most_recent", "true"),
+					resource.TestCheckResourceAttr(defaultDataSourceName, "most_recent", "false"),
+					resource.TestCheckResourceAttrPair(defaultDataSourceName, "version", addonResourceName, "addon_version"),
+					testAccCheckAWSEksAddonVersionNewer(latestDataSourceName, defaultDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAWSEksAddonVersionNewer verifies that the most recent add-on version is newer than the default one.
+// The vpc-cni add-on publishes releases ahead of the version EKS installs by default.
+func testAccCheckAWSEksAddonVersionNewer(latestResourceName, defaultResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		versions := make([]*gversion.Version, 0, 2)
+
+		for _, resourceName := range []string{latestResourceName, defaultResourceName} {
+			rs, ok := s.RootModule().Resources[resourceName]
+			if !ok {
+				return fmt.Errorf("Not found: %s", resourceName)
+			}
+
+			v, err := gversion.NewVersion(rs.Primary.Attributes["version"])
+			if err != nil {
+				return fmt.Errorf("error parsing %s version (%s): %w", resourceName, rs.Primary.Attributes["version"], err)
+			}
+
+			versions = append(versions, v)
+		}
+
+		if !versions[0].GreaterThan(versions[1]) {
+			return fmt.Errorf("expected most recent version (%s) to be newer than default version (%s)", versions[0], versions[1])
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEksAddonVersionDataSourceConfig(rName, addonName string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+data "aws_eks_addon_version" "latest" {
+  addon_name         = %[1]q
+  kubernetes_version = aws_eks_cluster.test.version
+  most_recent        = true
+}
+
+data "aws_eks_addon_version" "default" {
+  addon_name         = %[1]q
+  kubernetes_version = aws_eks_cluster.test.version
+  most_recent        = false
+}
+
+resource "aws_eks_addon" "test" {
+  addon_name        = %[1]q
+  cluster_name      = aws_eks_cluster.test.name
+  addon_version     = data.aws_eks_addon_version.default.version
+  resolve_conflicts = "OVERWRITE"
+}
+`, addonName))
+}
@@ -0,0 +1,356 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func dataSourceAwsIAMPrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMPrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(3, 128),
+				},
+			},
+			"additional_policies_json": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"additional_policies_json", "policy_source_arn"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(5, 256),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"expected_decision": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(iam.PolicyEvaluationDecisionType_Values(), false),
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIAMPolicyJson,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMPrincipalPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	actionNames := expandStringSet(d.Get("action_names").(*schema.Set))
+
+	var callerARN, resourceOwner, resourcePolicy *string
+	var contextEntries []*iam.ContextEntry
+	var permissionsBoundaryPolicies, policies, resourceARNs []*string
+
+	if v, ok := d.GetOk("additional_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+		policies = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("caller_arn"); ok {
+		callerARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		contextEntries = expandIamContextEntries(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+		permissionsBoundaryPolicies = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		resourceARNs = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_owner_account_id"); ok {
+		resourceOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		resourcePolicy = aws.String(v.(string))
+	}
+
+	var results []*iam.EvaluationResult
+
+	fn := func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.EvaluationResults...)
+
+		return !lastPage
+	}
+
+	var err error
+	policySourceARN := d.Get("policy_source_arn").(string)
+
+	if policySourceARN != "" {
+		input := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:                        actionNames,
+			CallerArn:                          callerARN,
+			ContextEntries:                     contextEntries,
+			PermissionsBoundaryPolicyInputList: permissionsBoundaryPolicies,
+			PolicyInputList:                    policies,
+			PolicySourceArn:                    aws.String(policySourceARN),
+			ResourceArns:                       resourceARNs,
+			ResourceOwner:                      resourceOwner,
+			ResourcePolicy:                     resourcePolicy,
+		}
+
+		log.Printf("[DEBUG] Simulating IAM Principal Policy: %s", input)
+		err = conn.SimulatePrincipalPolicyPages(input, fn)
+	} else {
+		input := &iam.SimulateCustomPolicyInput{
+			ActionNames:                        actionNames,
+			CallerArn:                          callerARN,
+			ContextEntries:                     contextEntries,
+			PermissionsBoundaryPolicyInputList: permissionsBoundaryPolicies,
+			PolicyInputList:                    policies,
+			ResourceArns:                       resourceARNs,
+			ResourceOwner:                      resourceOwner,
+			ResourcePolicy:                     resourcePolicy,
+		}
+
+		log.Printf("[DEBUG] Simulating IAM Custom Policy: %s", input)
+		err = conn.SimulateCustomPolicyPages(input, fn)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error simulating IAM policy: %w", err)
+	}
+
+	allAllowed := true
+	var mismatches []string
+	expectedDecision := d.Get("expected_decision").(string)
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		decision := aws.StringValue(result.EvalDecision)
+
+		if decision != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+		}
+
+		if expectedDecision != "" && decision != expectedDecision {
+			mismatches = append(mismatches, fmt.Sprintf("Action (%s) Resource (%s) Decision (%s)", aws.StringValue(result.EvalActionName), aws.StringValue(result.EvalResourceName), decision))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		sort.Strings(mismatches)
+		return fmt.Errorf("IAM policy simulation decisions do not match expected decision (%s):\n%s", expectedDecision, strings.Join(mismatches, "\n"))
+	}
+
+	idParts := append([]string{policySourceARN}, aws.StringValueSlice(actionNames)...)
+	idParts = append(idParts, aws.StringValueSlice(resourceARNs)...)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(idParts, ","))))
+
+	d.Set("all_allowed", allAllowed)
+
+	if err := d.Set("results", flattenIamEvaluationResults(results)); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	return nil
+}
+
+func expandIamContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iam.ContextEntry{}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.ContextKeyName = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.ContextKeyType = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ContextKeyValues = expandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIamEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action_name":          aws.StringValue(apiObject.EvalActionName),
+			"allowed":              aws.StringValue(apiObject.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision":             aws.StringValue(apiObject.EvalDecision),
+			"decision_details":     aws.StringValueMap(apiObject.EvalDecisionDetails),
+			"matched_statements":   flattenIamStatements(apiObject.MatchedStatements),
+			"missing_context_keys": aws.StringValueSlice(apiObject.MissingContextValues),
+			"resource_arn":         aws.StringValue(apiObject.EvalResourceName),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIamStatements(apiObjects []*iam.Statement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"source_policy_id":   aws.StringValue(apiObject.SourcePolicyId),
+			"source_policy_type": aws.StringValue(apiObject.SourcePolicyType),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
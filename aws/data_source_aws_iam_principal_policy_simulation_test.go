@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPrincipalPolicySimulation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfigPrincipal(rName, "s3:GetObject", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "role"),
+					resource.TestMatchResourceAttr(dataSourceName, "results.0.resource_arn", regexp.MustCompile(`/example$`)),
+				),
+			},
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfigPrincipal(rName, "s3:DeleteObject", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPrincipalPolicySimulation_ExpectedDecision(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfigPrincipal(rName, "s3:GetObject", "allowed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "expected_decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+				),
+			},
+			{
+				Config:      testAccAWSDataSourceIAMPrincipalPolicySimulationConfigPrincipal(rName, "s3:DeleteObject", "allowed"),
+				ExpectError: regexp.MustCompile(`do not match expected decision \(allowed\)`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPrincipalPolicySimulation_CustomPolicy(t *testing.T) {
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfigCustomPolicy(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name": "ec2:DescribeInstances",
+						"decision":    "allowed",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name": "ec2:TerminateInstances",
+						"decision":    "explicitDeny",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceIAMPrincipalPolicySimulationConfigPrincipal(rName, actionName, expectedDecision string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ec2.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = aws_iam_role.test.id
+  policy = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names      = [%[2]q]
+  policy_source_arn = aws_iam_role.test.arn
+  resource_arns     = ["${aws_s3_bucket.test.arn}/example"]
+
+  expected_decision = %[3]q != "" ? %[3]q : null
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, actionName, expectedDecision)
+}
+
+func testAccAWSDataSourceIAMPrincipalPolicySimulationConfigCustomPolicy() string {
+	return `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    effect    = "Deny"
+    actions   = ["ec2:TerminateInstances"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["us-west-2"]
+    }
+  }
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names             = ["ec2:DescribeInstances", "ec2:TerminateInstances"]
+  additional_policies_json = [data.aws_iam_policy_document.test.json]
+
+  context {
+    key    = "aws:RequestedRegion"
+    type   = "string"
+    values = ["us-east-1"]
+  }
+}
+`
+}
@@ -0,0 +1,307 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsInstancesDetail() *schema.Resource {
+	instanceSchema := dataSourceAwsInstance().Schema
+
+	return &schema.Resource{
+		Read: dataSourceAwsInstancesDetailRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"instance_state_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.InstanceStateName_Values(), false),
+				},
+			},
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ami": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ebs_optimized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enclave_options": instanceSchema["enclave_options"],
+						"host_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iam_instance_profile": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata_options": instanceSchema["metadata_options"],
+						"monitoring": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outpost_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"placement_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_dns": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_dns": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secondary_private_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source_dest_check": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+						"tenancy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_security_group_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsInstancesDetailRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceStateNames := []*string{aws.String(ec2.InstanceStateNameRunning)}
+	if v, ok := d.GetOk("instance_state_names"); ok && v.(*schema.Set).Len() > 0 {
+		instanceStateNames = expandStringSet(v.(*schema.Set))
+	}
+
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: instanceStateNames,
+			},
+		},
+	}
+
+	input.Filters = append(input.Filters, buildEC2TagFilterList(
+		keyvaluetags.New(d.Get("tags").(map[string]interface{})).Ec2Tags(),
+	)...)
+
+	input.Filters = append(input.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	var ids []string
+	var instances []interface{}
+
+	log.Printf("[DEBUG] Reading EC2 Instances: %s", input)
+	err := conn.DescribeInstancesPages(input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, reservation := range page.Reservations {
+			if reservation == nil {
+				continue
+			}
+
+			for _, instance := range reservation.Instances {
+				if instance == nil {
+					continue
+				}
+
+				ids = append(ids, aws.StringValue(instance.InstanceId))
+				instances = append(instances, flattenEc2InstanceDetail(instance, aws.StringValue(reservation.OwnerId), meta.(*AWSClient), ignoreTagsConfig))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instances: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("error setting instances: %w", err)
+	}
+
+	return nil
+}
+
+// flattenEc2InstanceDetail returns the instance attributes available from
+// DescribeInstances. Unlike the aws_instance data source, attributes that
+// require additional API calls per instance are not included.
+func flattenEc2InstanceDetail(apiObject *ec2.Instance, ownerID string, client *AWSClient, ignoreTagsConfig *keyvaluetags.IgnoreConfig) map[string]interface{} {
+	arn := arn.ARN{
+		Partition: client.partition,
+		Region:    client.region,
+		Service:   ec2.ServiceName,
+		AccountID: ownerID,
+		Resource:  fmt.Sprintf("instance/%s", aws.StringValue(apiObject.InstanceId)),
+	}.String()
+
+	tfMap := map[string]interface{}{
+		"ami":                  aws.StringValue(apiObject.ImageId),
+		"arn":                  arn,
+		"ebs_optimized":        aws.BoolValue(apiObject.EbsOptimized),
+		"enclave_options":      flattenEc2EnclaveOptions(apiObject.EnclaveOptions),
+		"iam_instance_profile": iamInstanceProfileArnToName(apiObject.IamInstanceProfile),
+		"id":                   aws.StringValue(apiObject.InstanceId),
+		"instance_type":        aws.StringValue(apiObject.InstanceType),
+		"key_name":             aws.StringValue(apiObject.KeyName),
+		"metadata_options":     flattenEc2InstanceMetadataOptions(apiObject.MetadataOptions),
+		"outpost_arn":          aws.StringValue(apiObject.OutpostArn),
+		"private_dns":          aws.StringValue(apiObject.PrivateDnsName),
+		"private_ip":           aws.StringValue(apiObject.PrivateIpAddress),
+		"public_dns":           aws.StringValue(apiObject.PublicDnsName),
+		"public_ip":            aws.StringValue(apiObject.PublicIpAddress),
+		"subnet_id":            aws.StringValue(apiObject.SubnetId),
+		"tags":                 keyvaluetags.Ec2KeyValueTags(apiObject.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map(),
+	}
+
+	if apiObject.State != nil {
+		tfMap["instance_state"] = aws.StringValue(apiObject.State.Name)
+	}
+
+	if v := apiObject.Placement; v != nil {
+		tfMap["availability_zone"] = aws.StringValue(v.AvailabilityZone)
+		tfMap["host_id"] = aws.StringValue(v.HostId)
+		tfMap["placement_group"] = aws.StringValue(v.GroupName)
+		tfMap["tenancy"] = aws.StringValue(v.Tenancy)
+	}
+
+	if v := apiObject.Monitoring; v != nil {
+		state := aws.StringValue(v.State)
+		tfMap["monitoring"] = state == ec2.MonitoringStateEnabled || state == ec2.MonitoringStatePending
+	}
+
+	if aws.StringValue(apiObject.SubnetId) != "" {
+		tfMap["source_dest_check"] = aws.BoolValue(apiObject.SourceDestCheck)
+	}
+
+	for _, ni := range apiObject.NetworkInterfaces {
+		if ni.Attachment == nil || aws.Int64Value(ni.Attachment.DeviceIndex) != 0 {
+			continue
+		}
+
+		tfMap["associate_public_ip_address"] = ni.Association != nil
+		tfMap["network_interface_id"] = aws.StringValue(ni.NetworkInterfaceId)
+		tfMap["subnet_id"] = aws.StringValue(ni.SubnetId)
+
+		var secondaryIPs []string
+		for _, ip := range ni.PrivateIpAddresses {
+			if !aws.BoolValue(ip.Primary) {
+				secondaryIPs = append(secondaryIPs, aws.StringValue(ip.PrivateIpAddress))
+			}
+		}
+		tfMap["secondary_private_ips"] = secondaryIPs
+	}
+
+	var securityGroupIDs, securityGroupNames []string
+	for _, sg := range apiObject.SecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, aws.StringValue(sg.GroupId))
+		securityGroupNames = append(securityGroupNames, aws.StringValue(sg.GroupName))
+	}
+	tfMap["security_groups"] = securityGroupNames
+	tfMap["vpc_security_group_ids"] = securityGroupIDs
+
+	return tfMap
+}
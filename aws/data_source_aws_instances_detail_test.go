@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsInstancesDetail_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_instances_detail.test"
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsInstancesDetailConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.ami", resourceName, "ami"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.availability_zone", resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.instance_state", "running"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.metadata_options.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.private_ip", resourceName, "private_ip"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.subnet_id", resourceName, "subnet_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.vpc_security_group_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsInstancesDetailConfig(rName string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAvailableAZsNoOptInConfig(),
+		testAccAvailableEc2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_instances_detail" "test" {
+  filter {
+    name   = "instance-id"
+    values = [aws_instance.test.id]
+  }
+}
+`, rName))
+}
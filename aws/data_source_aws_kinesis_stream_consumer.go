@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesis/finder"
+)
+
+func dataSourceAwsKinesisStreamConsumer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsKinesisStreamConsumerRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func dataSourceAwsKinesisStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisconn
+
+	streamArn := d.Get("stream_arn").(string)
+
+	var consumer *kinesis.ConsumerDescription
+	var err error
+
+	if v, ok := d.GetOk("arn"); ok {
+		consumer, err = finder.StreamConsumerByARN(conn, v.(string))
+	} else if v, ok := d.GetOk("name"); ok {
+		consumer, err = finder.StreamConsumerByStreamARNAndName(conn, streamArn, v.(string))
+	} else {
+		return fmt.Errorf("one of `arn` or `name` must be specified")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Stream Consumer: %w", err)
+	}
+
+	if aws.StringValue(consumer.StreamARN) != streamArn {
+		return fmt.Errorf("Kinesis Stream Consumer (%s) is not registered with stream (%s)", aws.StringValue(consumer.ConsumerARN), streamArn)
+	}
+
+	d.SetId(aws.StringValue(consumer.ConsumerARN))
+	d.Set("arn", consumer.ConsumerARN)
+	d.Set("creation_timestamp", aws.TimeValue(consumer.ConsumerCreationTimestamp).Format(time.RFC3339))
+	d.Set("name", consumer.ConsumerName)
+	d.Set("status", consumer.ConsumerStatus)
+	d.Set("stream_arn", consumer.StreamARN)
+
+	return nil
+}
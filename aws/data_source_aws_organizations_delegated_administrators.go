@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsOrganizationsDelegatedAdministrators() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsDelegatedAdministratorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"delegated_administrators": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delegation_enabled_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"joined_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"joined_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsDelegatedAdministratorsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.ListDelegatedAdministratorsInput{}
+
+	if v, ok := d.GetOk("service_principal"); ok {
+		input.ServicePrincipal = aws.String(v.(string))
+	}
+
+	var delegatedAdministrators []*organizations.DelegatedAdministrator
+
+	err := conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		delegatedAdministrators = append(delegatedAdministrators, page.DelegatedAdministrators...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Delegated Administrators: %w", err)
+	}
+
+	if err := d.Set("delegated_administrators", flattenOrganizationsDelegatedAdministrators(delegatedAdministrators)); err != nil {
+		return fmt.Errorf("error setting delegated_administrators: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return nil
+}
+
+func flattenOrganizationsDelegatedAdministrators(delegatedAdministrators []*organizations.DelegatedAdministrator) []map[string]interface{} {
+	var result []map[string]interface{}
+
+	for _, delegatedAdministrator := range delegatedAdministrators {
+		if delegatedAdministrator == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"arn":                     aws.StringValue(delegatedAdministrator.Arn),
+			"delegation_enabled_date": aws.TimeValue(delegatedAdministrator.DelegationEnabledDate).Format(time.RFC3339),
+			"email":                   aws.StringValue(delegatedAdministrator.Email),
+			"id":                      aws.StringValue(delegatedAdministrator.Id),
+			"joined_method":           aws.StringValue(delegatedAdministrator.JoinedMethod),
+			"joined_timestamp":        aws.TimeValue(delegatedAdministrator.JoinedTimestamp).Format(time.RFC3339),
+			"name":                    aws.StringValue(delegatedAdministrator.Name),
+			"status":                  aws.StringValue(delegatedAdministrator.Status),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsRouteTablesDetail_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_route_tables_detail.test"
+	resourceName := "aws_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRouteTablesDetailConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_tables.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_tables.0.associations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_tables.0.associations.0.subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_tables.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_tables.0.owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_tables.0.routes.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_tables.0.routes.0.gateway_id", "aws_internet_gateway.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_tables.0.tags.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_tables.0.vpc_id", resourceName, "vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsRouteTablesDetailConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.1.1.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = aws_route_table.test.id
+  subnet_id      = aws_subnet.test.id
+}
+
+data "aws_route_tables_detail" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, rName)
+}
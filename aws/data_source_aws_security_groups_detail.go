@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsSecurityGroupsDetail() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSecurityGroupsDetailRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsSecurityGroupsDetailRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeSecurityGroupsInput{}
+
+	input.Filters = buildEC2TagFilterList(
+		keyvaluetags.New(d.Get("tags").(map[string]interface{})).Ec2Tags(),
+	)
+
+	input.Filters = append(input.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	var ids []string
+	var securityGroups []interface{}
+
+	log.Printf("[DEBUG] Reading EC2 Security Groups: %s", input)
+	err := conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, sg := range page.SecurityGroups {
+			if sg == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(sg.GroupId))
+			securityGroups = append(securityGroups, flattenEc2SecurityGroupDetail(sg, meta.(*AWSClient), ignoreTagsConfig))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Security Groups: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("security_groups", securityGroups); err != nil {
+		return fmt.Errorf("error setting security_groups: %w", err)
+	}
+
+	return nil
+}
+
+func flattenEc2SecurityGroupDetail(apiObject *ec2.SecurityGroup, client *AWSClient, ignoreTagsConfig *keyvaluetags.IgnoreConfig) map[string]interface{} {
+	arn := arn.ARN{
+		Partition: client.partition,
+		Service:   ec2.ServiceName,
+		Region:    client.region,
+		AccountID: aws.StringValue(apiObject.OwnerId),
+		Resource:  fmt.Sprintf("security-group/%s", aws.StringValue(apiObject.GroupId)),
+	}.String()
+
+	return map[string]interface{}{
+		"arn":         arn,
+		"description": aws.StringValue(apiObject.Description),
+		"id":          aws.StringValue(apiObject.GroupId),
+		"name":        aws.StringValue(apiObject.GroupName),
+		"owner_id":    aws.StringValue(apiObject.OwnerId),
+		"tags":        keyvaluetags.Ec2KeyValueTags(apiObject.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map(),
+		"vpc_id":      aws.StringValue(apiObject.VpcId),
+	}
+}
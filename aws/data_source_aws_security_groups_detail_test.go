@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsSecurityGroupsDetail_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_security_groups_detail.test"
+	resourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSecurityGroupsDetailConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_groups.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_groups.0.description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_groups.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_groups.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_groups.0.owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_groups.0.tags.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_groups.0.vpc_id", resourceName, "vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsSecurityGroupsDetailConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name        = %[1]q
+  description = %[1]q
+  vpc_id      = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_security_groups_detail" "test" {
+  filter {
+    name   = "vpc-id"
+    values = [aws_vpc.test.id]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_security_group.test]
+}
+`, rName)
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsSubnetsDetail() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSubnetsDetailRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assign_ipv6_address_on_creation": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"customer_owned_ipv4_pool": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_for_az": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_cidr_block_association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"map_customer_owned_ip_on_launch": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"map_public_ip_on_launch": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"outpost_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchemaComputed(),
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSubnetsDetailRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeSubnetsInput{}
+
+	input.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"vpc-id": d.Get("vpc_id").(string),
+		},
+	)
+
+	input.Filters = append(input.Filters, buildEC2TagFilterList(
+		keyvaluetags.New(d.Get("tags").(map[string]interface{})).Ec2Tags(),
+	)...)
+
+	input.Filters = append(input.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	var ids []string
+	var subnets []interface{}
+
+	log.Printf("[DEBUG] Reading EC2 Subnets: %s", input)
+	err := conn.DescribeSubnetsPages(input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, subnet := range page.Subnets {
+			if subnet == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(subnet.SubnetId))
+			subnets = append(subnets, flattenEc2SubnetDetail(subnet, ignoreTagsConfig))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Subnets: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("subnets", subnets); err != nil {
+		return fmt.Errorf("error setting subnets: %w", err)
+	}
+
+	return nil
+}
+
+func flattenEc2SubnetDetail(apiObject *ec2.Subnet, ignoreTagsConfig *keyvaluetags.IgnoreConfig) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"arn":                             aws.StringValue(apiObject.SubnetArn),
+		"assign_ipv6_address_on_creation": aws.BoolValue(apiObject.AssignIpv6AddressOnCreation),
+		"availability_zone":               aws.StringValue(apiObject.AvailabilityZone),
+		"availability_zone_id":            aws.StringValue(apiObject.AvailabilityZoneId),
+		"cidr_block":                      aws.StringValue(apiObject.CidrBlock),
+		"customer_owned_ipv4_pool":        aws.StringValue(apiObject.CustomerOwnedIpv4Pool),
+		"default_for_az":                  aws.BoolValue(apiObject.DefaultForAz),
+		"id":                              aws.StringValue(apiObject.SubnetId),
+		"map_customer_owned_ip_on_launch": aws.BoolValue(apiObject.MapCustomerOwnedIpOnLaunch),
+		"map_public_ip_on_launch":         aws.BoolValue(apiObject.MapPublicIpOnLaunch),
+		"outpost_arn":                     aws.StringValue(apiObject.OutpostArn),
+		"owner_id":                        aws.StringValue(apiObject.OwnerId),
+		"state":                           aws.StringValue(apiObject.State),
+		"tags":                            keyvaluetags.Ec2KeyValueTags(apiObject.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map(),
+		"vpc_id":                          aws.StringValue(apiObject.VpcId),
+	}
+
+	// Only one IPv6 CIDR block can be associated at once
+	for _, a := range apiObject.Ipv6CidrBlockAssociationSet {
+		if a.Ipv6CidrBlockState != nil && aws.StringValue(a.Ipv6CidrBlockState.State) == ec2.SubnetCidrBlockStateCodeAssociated {
+			tfMap["ipv6_cidr_block"] = aws.StringValue(a.Ipv6CidrBlock)
+			tfMap["ipv6_cidr_block_association_id"] = aws.StringValue(a.AssociationId)
+		}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsSubnetsDetail_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_subnets_detail.test"
+	resourceName := "aws_subnet.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSubnetsDetailConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_subnets_detail.filter", "subnets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets_detail.filter", "subnets.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets_detail.filter", "subnets.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets_detail.filter", "subnets.0.availability_zone", resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets_detail.filter", "subnets.0.cidr_block", resourceName, "cidr_block"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets_detail.filter", "subnets.0.owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttr("data.aws_subnets_detail.filter", "subnets.0.tags.%", "2"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets_detail.filter", "subnets.0.vpc_id", resourceName, "vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsSubnetsDetailConfig(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name  = %[1]q
+    Index = count.index
+  }
+}
+
+data "aws_subnets_detail" "test" {
+  vpc_id = aws_vpc.test.id
+
+  depends_on = [aws_subnet.test]
+}
+
+data "aws_subnets_detail" "filter" {
+  vpc_id = aws_vpc.test.id
+
+  filter {
+    name   = "cidr-block"
+    values = [aws_subnet.test[0].cidr_block]
+  }
+}
+`, rName))
+}
@@ -0,0 +1,140 @@
+package keyvaluetags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	tagPolicyAssignOperator = `@@assign`
+	tagPolicyWildcard       = `*`
+)
+
+// TagPolicy contains the tag key and value rules of an AWS Organizations
+// effective tag policy, keyed by lowercase tag key.
+type TagPolicy map[string]*TagPolicyRule
+
+// TagPolicyRule contains the rules for a single tag key.
+type TagPolicyRule struct {
+	// Key is the tag key with its required capitalization.
+	Key string
+
+	// Values are the allowed tag values, which may contain a single asterisk
+	// wildcard. An empty list allows any value.
+	Values []string
+}
+
+// NewTagPolicy parses the content of an AWS Organizations effective tag policy.
+func NewTagPolicy(content string) (TagPolicy, error) {
+	var document struct {
+		Tags map[string]struct {
+			TagKey   json.RawMessage `json:"tag_key"`
+			TagValue json.RawMessage `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("error parsing tag policy: %w", err)
+	}
+
+	policy := make(TagPolicy)
+
+	for name, tag := range document.Tags {
+		rule := &TagPolicyRule{}
+
+		if err := tagPolicyUnmarshalAssign(tag.TagKey, &rule.Key); err != nil {
+			return nil, fmt.Errorf("error parsing tag policy key (%s): %w", name, err)
+		}
+
+		if rule.Key == "" {
+			rule.Key = name
+		}
+
+		if err := tagPolicyUnmarshalAssign(tag.TagValue, &rule.Values); err != nil {
+			return nil, fmt.Errorf("error parsing tag policy key (%s) values: %w", name, err)
+		}
+
+		policy[strings.ToLower(rule.Key)] = rule
+	}
+
+	return policy, nil
+}
+
+// Violations returns a description of each tag that does not comply with the
+// tag policy, sorted by tag key. Tags not covered by the policy are ignored.
+func (policy TagPolicy) Violations(tags KeyValueTags) []string {
+	var violations []string
+
+	for _, k := range tags.Keys() {
+		rule, ok := policy[strings.ToLower(k)]
+
+		if !ok {
+			continue
+		}
+
+		if k != rule.Key {
+			violations = append(violations, fmt.Sprintf("tag key (%s) does not match the capitalization required by tag policy (%s)", k, rule.Key))
+		}
+
+		if len(rule.Values) == 0 {
+			continue
+		}
+
+		value := ""
+		if v := tags.KeyValue(k); v != nil {
+			value = *v
+		}
+
+		if !rule.allowsValue(value) {
+			violations = append(violations, fmt.Sprintf("tag key (%s) value (%s) is not one of the values allowed by tag policy: %s", k, value, strings.Join(rule.Values, ", ")))
+		}
+	}
+
+	sort.Strings(violations)
+
+	return violations
+}
+
+func (rule *TagPolicyRule) allowsValue(value string) bool {
+	for _, allowed := range rule.Values {
+		parts := strings.SplitN(allowed, tagPolicyWildcard, 2)
+
+		if len(parts) == 1 {
+			if value == allowed {
+				return true
+			}
+
+			continue
+		}
+
+		prefix, suffix := parts[0], parts[1]
+
+		if len(value) >= len(prefix)+len(suffix) && strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tagPolicyUnmarshalAssign unmarshals a tag policy element that is either a
+// plain value or an object containing the value assignment operator.
+func tagPolicyUnmarshalAssign(data json.RawMessage, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var operators map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &operators); err == nil {
+		data = operators[tagPolicyAssignOperator]
+
+		if len(data) == 0 {
+			return nil
+		}
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,145 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewTagPolicy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    TagPolicy
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			content: `{}`,
+			want:    TagPolicy{},
+		},
+		{
+			name:    "invalid",
+			content: `{"tags":`,
+			wantErr: true,
+		},
+		{
+			name:    "assign operators",
+			content: `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100","200"]},"enforced_for":{"@@assign":["ec2:instance"]}}}}`,
+			want: TagPolicy{
+				"costcenter": {Key: "CostCenter", Values: []string{"100", "200"}},
+			},
+		},
+		{
+			name:    "plain values",
+			content: `{"tags":{"project":{"tag_key":"Project","tag_value":["Alpha*"]}}}`,
+			want: TagPolicy{
+				"project": {Key: "Project", Values: []string{"Alpha*"}},
+			},
+		},
+		{
+			name:    "missing tag_key",
+			content: `{"tags":{"owner":{}}}`,
+			want: TagPolicy{
+				"owner": {Key: "owner"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := NewTagPolicy(testCase.content)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %#v, want %#v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestTagPolicyViolations(t *testing.T) {
+	policy := TagPolicy{
+		"costcenter": {Key: "CostCenter", Values: []string{"100", "200"}},
+		"owner":      {Key: "Owner", Values: []string{"*@example.com"}},
+		"project":    {Key: "Project"},
+	}
+
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+		},
+		{
+			name: "compliant",
+			tags: New(map[string]string{
+				"CostCenter": "100",
+				"Owner":      "team@example.com",
+				"Project":    "anything",
+				"Unmanaged":  "value",
+			}),
+		},
+		{
+			name: "key capitalization",
+			tags: New(map[string]string{
+				"project": "anything",
+			}),
+			want: []string{
+				"tag key (project) does not match the capitalization required by tag policy (Project)",
+			},
+		},
+		{
+			name: "value",
+			tags: New(map[string]string{
+				"CostCenter": "300",
+			}),
+			want: []string{
+				"tag key (CostCenter) value (300) is not one of the values allowed by tag policy: 100, 200",
+			},
+		},
+		{
+			name: "wildcard value",
+			tags: New(map[string]string{
+				"Owner": "team@example.org",
+			}),
+			want: []string{
+				"tag key (Owner) value (team@example.org) is not one of the values allowed by tag policy: *@example.com",
+			},
+		},
+		{
+			name: "multiple",
+			tags: New(map[string]string{
+				"costcenter": "300",
+				"Project":    "anything",
+			}),
+			want: []string{
+				"tag key (costcenter) does not match the capitalization required by tag policy (CostCenter)",
+				"tag key (costcenter) value (300) is not one of the values allowed by tag policy: 100, 200",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := policy.Violations(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %#v, want %#v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+)
+
+// Deployment returns the deployment corresponding to the specified application, environment and deployment number.
+func Deployment(conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int64) (*appconfig.GetDeploymentOutput, error) {
+	input := &appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(applicationID),
+		DeploymentNumber: aws.Int64(deploymentNumber),
+		EnvironmentId:    aws.String(environmentID),
+	}
+
+	output, err := conn.GetDeployment(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
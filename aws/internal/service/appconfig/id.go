@@ -0,0 +1,89 @@
+package appconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const resourceIDSeparator = "/"
+
+func EnvironmentCreateResourceID(applicationID, environmentID string) string {
+	parts := []string{applicationID, environmentID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func EnvironmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected application-id%[2]senvironment-id", id, resourceIDSeparator)
+}
+
+func ConfigurationProfileCreateResourceID(applicationID, configurationProfileID string) string {
+	parts := []string{applicationID, configurationProfileID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func ConfigurationProfileParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected application-id%[2]sconfiguration-profile-id", id, resourceIDSeparator)
+}
+
+func HostedConfigurationVersionCreateResourceID(applicationID, configurationProfileID string, versionNumber int64) string {
+	parts := []string{applicationID, configurationProfileID, strconv.FormatInt(versionNumber, 10)}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func HostedConfigurationVersionParseResourceID(id string) (string, string, int64, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		versionNumber, err := strconv.ParseInt(parts[2], 10, 64)
+
+		if err != nil {
+			return "", "", 0, fmt.Errorf("error parsing version number from ID (%s): %w", id, err)
+		}
+
+		return parts[0], parts[1], versionNumber, nil
+	}
+
+	return "", "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected application-id%[2]sconfiguration-profile-id%[2]sversion-number", id, resourceIDSeparator)
+}
+
+func DeploymentCreateResourceID(applicationID, environmentID string, deploymentNumber int64) string {
+	parts := []string{applicationID, environmentID, strconv.FormatInt(deploymentNumber, 10)}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func DeploymentParseResourceID(id string) (string, string, int64, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		deploymentNumber, err := strconv.ParseInt(parts[2], 10, 64)
+
+		if err != nil {
+			return "", "", 0, fmt.Errorf("error parsing deployment number from ID (%s): %w", id, err)
+		}
+
+		return parts[0], parts[1], deploymentNumber, nil
+	}
+
+	return "", "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected application-id%[2]senvironment-id%[2]sdeployment-number", id, resourceIDSeparator)
+}
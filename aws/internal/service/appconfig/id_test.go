@@ -0,0 +1,153 @@
+package appconfig
+
+import (
+	"testing"
+)
+
+func TestEnvironmentParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		InputID               string
+		ExpectError           bool
+		ExpectedApplicationID string
+		ExpectedEnvironmentID string
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "application ID only",
+			InputID:     "abc1234",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing application ID",
+			InputID:     "/def5678",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing environment ID",
+			InputID:     "abc1234/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "abc1234/def5678/extra",
+			ExpectError: true,
+		},
+		{
+			TestName:              "valid",
+			InputID:               "abc1234/def5678",
+			ExpectedApplicationID: "abc1234",
+			ExpectedEnvironmentID: "def5678",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotApplicationID, gotEnvironmentID, err := EnvironmentParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotApplicationID != testCase.ExpectedApplicationID {
+				t.Errorf("expected application ID %q, got %q", testCase.ExpectedApplicationID, gotApplicationID)
+			}
+
+			if gotEnvironmentID != testCase.ExpectedEnvironmentID {
+				t.Errorf("expected environment ID %q, got %q", testCase.ExpectedEnvironmentID, gotEnvironmentID)
+			}
+		})
+	}
+}
+
+func TestHostedConfigurationVersionParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                       string
+		InputID                        string
+		ExpectError                    bool
+		ExpectedApplicationID          string
+		ExpectedConfigurationProfileID string
+		ExpectedVersionNumber          int64
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing version number",
+			InputID:     "abc1234/def5678",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty version number",
+			InputID:     "abc1234/def5678/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "non-numeric version number",
+			InputID:     "abc1234/def5678/latest",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "abc1234/def5678/1/extra",
+			ExpectError: true,
+		},
+		{
+			TestName:                       "valid",
+			InputID:                        "abc1234/def5678/12",
+			ExpectedApplicationID:          "abc1234",
+			ExpectedConfigurationProfileID: "def5678",
+			ExpectedVersionNumber:          12,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotApplicationID, gotConfigurationProfileID, gotVersionNumber, err := HostedConfigurationVersionParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotApplicationID != testCase.ExpectedApplicationID {
+				t.Errorf("expected application ID %q, got %q", testCase.ExpectedApplicationID, gotApplicationID)
+			}
+
+			if gotConfigurationProfileID != testCase.ExpectedConfigurationProfileID {
+				t.Errorf("expected configuration profile ID %q, got %q", testCase.ExpectedConfigurationProfileID, gotConfigurationProfileID)
+			}
+
+			if gotVersionNumber != testCase.ExpectedVersionNumber {
+				t.Errorf("expected version number %d, got %d", testCase.ExpectedVersionNumber, gotVersionNumber)
+			}
+		})
+	}
+}
+
+func TestDeploymentResourceIDRoundTrip(t *testing.T) {
+	id := DeploymentCreateResourceID("abc1234", "def5678", 3)
+
+	gotApplicationID, gotEnvironmentID, gotDeploymentNumber, err := DeploymentParseResourceID(id)
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing %q: %s", id, err)
+	}
+
+	if gotApplicationID != "abc1234" || gotEnvironmentID != "def5678" || gotDeploymentNumber != 3 {
+		t.Errorf("expected (%q, %q, %d) from %q, got (%q, %q, %d)", "abc1234", "def5678", 3, id, gotApplicationID, gotEnvironmentID, gotDeploymentNumber)
+	}
+}
@@ -0,0 +1,30 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig/finder"
+)
+
+const (
+	DeploymentStateNotFound = "NotFound"
+	DeploymentStateUnknown  = "Unknown"
+)
+
+// DeploymentState fetches the Deployment and its State
+func DeploymentState(conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.Deployment(conn, applicationID, environmentID, deploymentNumber)
+
+		if err != nil {
+			return nil, DeploymentStateUnknown, err
+		}
+
+		if output == nil {
+			return nil, DeploymentStateNotFound, nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -0,0 +1,36 @@
+package waiter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DeploymentCompleted waits for a Deployment to return Complete
+func DeploymentCompleted(conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int64, timeout time.Duration) (*appconfig.GetDeploymentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			appconfig.DeploymentStateBaking,
+			appconfig.DeploymentStateDeploying,
+			appconfig.DeploymentStateRollingBack,
+			appconfig.DeploymentStateValidating,
+		},
+		Target:  []string{appconfig.DeploymentStateComplete},
+		Refresh: DeploymentState(conn, applicationID, environmentID, deploymentNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appconfig.GetDeploymentOutput); ok {
+		if state := output.State; state != nil && *state == appconfig.DeploymentStateRolledBack {
+			err = errors.New("deployment rolled back")
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -55,40 +55,52 @@ func StackSetOperationStatus(conn *cloudformation.CloudFormation, stackSetName,
 		}
 
 		if aws.StringValue(output.StackSetOperation.Status) == cloudformation.StackSetOperationStatusFailed {
-			allResults := make([]string, 0)
-			listOperationResultsInput := &cloudformation.ListStackSetOperationResultsInput{
-				OperationId:  aws.String(operationID),
-				StackSetName: aws.String(stackSetName),
+			failures, err := stackSetOperationFailures(conn, stackSetName, operationID)
+
+			if err != nil {
+				return output.StackSetOperation, cloudformation.StackSetOperationStatusFailed, fmt.Errorf("error listing Operation (%s) errors: %w", operationID, err)
 			}
 
-			// TODO: PAGES
-			for {
-				listOperationResultsOutput, err := conn.ListStackSetOperationResults(listOperationResultsInput)
+			return output.StackSetOperation, cloudformation.StackSetOperationStatusFailed, fmt.Errorf("Operation (%s) Results:\n%s", operationID, strings.Join(failures, "\n"))
+		}
 
-				if err != nil {
-					return output.StackSetOperation, cloudformation.StackSetOperationStatusFailed, fmt.Errorf("error listing Operation (%s) errors: %w", operationID, err)
-				}
+		return output.StackSetOperation, aws.StringValue(output.StackSetOperation.Status), nil
+	}
+}
 
-				if listOperationResultsOutput == nil {
-					continue
-				}
+// stackSetOperationFailures returns a description of each account and Region
+// in which the specified StackSet operation did not succeed.
+func stackSetOperationFailures(conn *cloudformation.CloudFormation, stackSetName, operationID string) ([]string, error) {
+	var failures []string
 
-				for _, summary := range listOperationResultsOutput.Summaries {
-					allResults = append(allResults, fmt.Sprintf("Account (%s) Region (%s) Status (%s) Status Reason: %s", aws.StringValue(summary.Account), aws.StringValue(summary.Region), aws.StringValue(summary.Status), aws.StringValue(summary.StatusReason)))
-				}
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		OperationId:  aws.String(operationID),
+		StackSetName: aws.String(stackSetName),
+	}
 
-				if aws.StringValue(listOperationResultsOutput.NextToken) == "" {
-					break
-				}
+	err := conn.ListStackSetOperationResultsPages(input, func(page *cloudformation.ListStackSetOperationResultsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-				listOperationResultsInput.NextToken = listOperationResultsOutput.NextToken
+		for _, summary := range page.Summaries {
+			if summary == nil || aws.StringValue(summary.Status) == cloudformation.StackSetOperationResultStatusSucceeded {
+				continue
 			}
 
-			return output.StackSetOperation, cloudformation.StackSetOperationStatusFailed, fmt.Errorf("Operation (%s) Results:\n%s", operationID, strings.Join(allResults, "\n"))
+			failure := fmt.Sprintf("Account (%s) Region (%s) Status (%s) Status Reason: %s", aws.StringValue(summary.Account), aws.StringValue(summary.Region), aws.StringValue(summary.Status), aws.StringValue(summary.StatusReason))
+
+			if v := aws.StringValue(summary.OrganizationalUnitId); v != "" {
+				failure = fmt.Sprintf("Organizational Unit (%s) %s", v, failure)
+			}
+
+			failures = append(failures, failure)
 		}
 
-		return output.StackSetOperation, aws.StringValue(output.StackSetOperation.Status), nil
-	}
+		return !lastPage
+	})
+
+	return failures, err
 }
 
 const (
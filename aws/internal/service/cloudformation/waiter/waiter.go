@@ -71,7 +71,23 @@ func StackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSetNam
 	log.Printf("[DEBUG] Waiting for CloudFormation StackSet (%s) operation: %s", stackSetName, operationID)
 	_, err := stateConf.WaitForState()
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	// An operation can succeed within its failure tolerance while individual accounts fail.
+	failures, err := stackSetOperationFailures(conn, stackSetName, operationID)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to list CloudFormation StackSet (%s) operation (%s) results: %s", stackSetName, operationID, err)
+		return nil
+	}
+
+	for _, failure := range failures {
+		log.Printf("[WARN] CloudFormation StackSet (%s) operation (%s) did not succeed in %s", stackSetName, operationID, failure)
+	}
+
+	return nil
 }
 
 const (
@@ -0,0 +1,54 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ArchiveStatusUnknown = "Unknown"
+	ReplayStatusUnknown  = "Unknown"
+)
+
+// ArchiveStatus fetches the Archive and its State
+func ArchiveStatus(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &events.DescribeArchiveInput{
+			ArchiveName: aws.String(name),
+		}
+
+		output, err := conn.DescribeArchive(input)
+
+		if err != nil {
+			return nil, ArchiveStatusUnknown, err
+		}
+
+		if output == nil {
+			return output, ArchiveStatusUnknown, nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// ReplayStatus fetches the Replay and its State
+func ReplayStatus(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &events.DescribeReplayInput{
+			ReplayName: aws.String(name),
+		}
+
+		output, err := conn.DescribeReplay(input)
+
+		if err != nil {
+			return nil, ReplayStatusUnknown, err
+		}
+
+		if output == nil {
+			return output, ReplayStatusUnknown, nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
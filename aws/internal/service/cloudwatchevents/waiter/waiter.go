@@ -0,0 +1,94 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Archive to be enabled
+	ArchiveEnabledTimeout = 5 * time.Minute
+
+	// Delay between Replay status checks
+	ReplayCompletedDelay = 10 * time.Second
+)
+
+// ArchiveEnabled waits for an Archive to return Enabled
+func ArchiveEnabled(conn *events.CloudWatchEvents, name string) (*events.DescribeArchiveOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			events.ArchiveStateCreating,
+			events.ArchiveStateUpdating,
+		},
+		Target: []string{
+			events.ArchiveStateDisabled,
+			events.ArchiveStateEnabled,
+		},
+		Refresh: ArchiveStatus(conn, name),
+		Timeout: ArchiveEnabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*events.DescribeArchiveOutput); ok {
+		if state := aws.StringValue(output.State); state == events.ArchiveStateCreateFailed || state == events.ArchiveStateUpdateFailed {
+			err = fmt.Errorf("%w: %s", err, aws.StringValue(output.StateReason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ReplayCompleted waits for a Replay to return Completed
+func ReplayCompleted(conn *events.CloudWatchEvents, name string, timeout time.Duration) (*events.DescribeReplayOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			events.ReplayStateRunning,
+			events.ReplayStateStarting,
+		},
+		Target:  []string{events.ReplayStateCompleted},
+		Refresh: ReplayStatus(conn, name),
+		Timeout: timeout,
+		Delay:   ReplayCompletedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*events.DescribeReplayOutput); ok {
+		if state := aws.StringValue(output.State); state == events.ReplayStateCancelled || state == events.ReplayStateFailed {
+			err = fmt.Errorf("%w: %s", err, aws.StringValue(output.StateReason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ReplayCancelled waits for a Replay to return Cancelled
+func ReplayCancelled(conn *events.CloudWatchEvents, name string, timeout time.Duration) (*events.DescribeReplayOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ReplayStateCancelling},
+		Target: []string{
+			events.ReplayStateCancelled,
+			events.ReplayStateCompleted,
+			events.ReplayStateFailed,
+		},
+		Refresh: ReplayStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*events.DescribeReplayOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
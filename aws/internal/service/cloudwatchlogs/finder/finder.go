@@ -0,0 +1,44 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+// QueryDefinition returns the CloudWatch Logs Insights query definition with the
+// specified ID. If name is not empty it is used to narrow the search.
+func QueryDefinition(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, name, queryDefinitionID string) (*cloudwatchlogs.QueryDefinition, error) {
+	input := &cloudwatchlogs.DescribeQueryDefinitionsInput{}
+
+	if name != "" {
+		input.QueryDefinitionNamePrefix = aws.String(name)
+	}
+
+	for {
+		output, err := conn.DescribeQueryDefinitionsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, nil
+		}
+
+		for _, queryDefinition := range output.QueryDefinitions {
+			if aws.StringValue(queryDefinition.QueryDefinitionId) == queryDefinitionID {
+				return queryDefinition, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,85 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ContributorInsights returns the Contributor Insights for the specified table and (optional) index.
+// Returns NotFoundError if the table or index is not found.
+func ContributorInsights(conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	input := &dynamodb.DescribeContributorInsightsInput{
+		TableName: aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	output, err := conn.DescribeContributorInsights(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// KinesisDataStreamDestination returns the Kinesis streaming destination for the specified table and stream ARN.
+// Returns NotFoundError if the table or destination is not found.
+func KinesisDataStreamDestination(conn *dynamodb.DynamoDB, tableName, streamArn string) (*dynamodb.KinesisDataStreamDestination, error) {
+	input := &dynamodb.DescribeKinesisStreamingDestinationInput{
+		TableName: aws.String(tableName),
+	}
+
+	output, err := conn.DescribeKinesisStreamingDestination(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	for _, destination := range output.KinesisDataStreamDestinations {
+		if destination == nil {
+			continue
+		}
+
+		if aws.StringValue(destination.StreamArn) == streamArn {
+			return destination, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Kinesis streaming destination not found",
+		LastRequest: input,
+	}
+}
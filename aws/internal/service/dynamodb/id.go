@@ -0,0 +1,61 @@
+package dynamodb
+
+import (
+	"fmt"
+	"strings"
+)
+
+const kinesisStreamingDestinationResourceIDSeparator = ","
+
+func KinesisStreamingDestinationCreateResourceID(tableName, streamArn string) string {
+	parts := []string{tableName, streamArn}
+	id := strings.Join(parts, kinesisStreamingDestinationResourceIDSeparator)
+
+	return id
+}
+
+func KinesisStreamingDestinationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, kinesisStreamingDestinationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected table-name%[2]sstream-arn", id, kinesisStreamingDestinationResourceIDSeparator)
+}
+
+const (
+	contributorInsightsResourceIDTablePrefix = "name:"
+	contributorInsightsResourceIDIndexPrefix = "index:"
+	contributorInsightsResourceIDSeparator   = "/"
+)
+
+func ContributorInsightsCreateResourceID(tableName, indexName string) string {
+	id := contributorInsightsResourceIDTablePrefix + tableName
+
+	if indexName != "" {
+		id += contributorInsightsResourceIDSeparator + contributorInsightsResourceIDIndexPrefix + indexName
+	}
+
+	return id
+}
+
+func ContributorInsightsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, contributorInsightsResourceIDSeparator)
+
+	switch {
+	case len(parts) == 1 && strings.HasPrefix(parts[0], contributorInsightsResourceIDTablePrefix):
+		if tableName := strings.TrimPrefix(parts[0], contributorInsightsResourceIDTablePrefix); tableName != "" {
+			return tableName, "", nil
+		}
+	case len(parts) == 2 && strings.HasPrefix(parts[0], contributorInsightsResourceIDTablePrefix) && strings.HasPrefix(parts[1], contributorInsightsResourceIDIndexPrefix):
+		tableName := strings.TrimPrefix(parts[0], contributorInsightsResourceIDTablePrefix)
+		indexName := strings.TrimPrefix(parts[1], contributorInsightsResourceIDIndexPrefix)
+
+		if tableName != "" && indexName != "" {
+			return tableName, indexName, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected %[2]stable-name or %[2]stable-name%[3]s%[4]sindex-name", id, contributorInsightsResourceIDTablePrefix, contributorInsightsResourceIDSeparator, contributorInsightsResourceIDIndexPrefix)
+}
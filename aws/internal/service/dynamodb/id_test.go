@@ -0,0 +1,164 @@
+package dynamodb
+
+import (
+	"testing"
+)
+
+func TestKinesisStreamingDestinationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		InputID           string
+		ExpectError       bool
+		ExpectedTableName string
+		ExpectedStreamArn string
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "table name only",
+			InputID:     "example",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing table name",
+			InputID:     ",arn:aws:kinesis:us-west-2:123456789012:stream/example",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing stream ARN",
+			InputID:     "example,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "example,arn:aws:kinesis:us-west-2:123456789012:stream/example,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:          "valid",
+			InputID:           "example,arn:aws:kinesis:us-west-2:123456789012:stream/example",
+			ExpectedTableName: "example",
+			ExpectedStreamArn: "arn:aws:kinesis:us-west-2:123456789012:stream/example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotTableName, gotStreamArn, err := KinesisStreamingDestinationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotTableName != testCase.ExpectedTableName {
+				t.Errorf("expected table name %q, got %q", testCase.ExpectedTableName, gotTableName)
+			}
+
+			if gotStreamArn != testCase.ExpectedStreamArn {
+				t.Errorf("expected stream ARN %q, got %q", testCase.ExpectedStreamArn, gotStreamArn)
+			}
+		})
+	}
+}
+
+func TestContributorInsightsParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		InputID           string
+		ExpectError       bool
+		ExpectedTableName string
+		ExpectedIndexName string
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing table prefix",
+			InputID:     "example",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty table name",
+			InputID:     "name:",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing index prefix",
+			InputID:     "name:example/example-index",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty index name",
+			InputID:     "name:example/index:",
+			ExpectError: true,
+		},
+		{
+			TestName:    "index without table",
+			InputID:     "index:example-index",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "name:example/index:example-index/extra",
+			ExpectError: true,
+		},
+		{
+			TestName:          "table",
+			InputID:           "name:example",
+			ExpectedTableName: "example",
+		},
+		{
+			TestName:          "table and index",
+			InputID:           "name:example/index:example-index",
+			ExpectedTableName: "example",
+			ExpectedIndexName: "example-index",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotTableName, gotIndexName, err := ContributorInsightsParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotTableName != testCase.ExpectedTableName {
+				t.Errorf("expected table name %q, got %q", testCase.ExpectedTableName, gotTableName)
+			}
+
+			if gotIndexName != testCase.ExpectedIndexName {
+				t.Errorf("expected index name %q, got %q", testCase.ExpectedIndexName, gotIndexName)
+			}
+		})
+	}
+}
+
+func TestContributorInsightsResourceIDRoundTrip(t *testing.T) {
+	for _, indexName := range []string{"", "example-index"} {
+		id := ContributorInsightsCreateResourceID("example", indexName)
+
+		gotTableName, gotIndexName, err := ContributorInsightsParseResourceID(id)
+
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", id, err)
+		}
+
+		if gotTableName != "example" || gotIndexName != indexName {
+			t.Errorf("expected (%q, %q) from %q, got (%q, %q)", "example", indexName, id, gotTableName, gotIndexName)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ContributorInsightsStatus fetches the Contributor Insights and its Status
+func ContributorInsightsStatus(conn *dynamodb.DynamoDB, tableName, indexName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ContributorInsights(conn, tableName, indexName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ContributorInsightsStatus), nil
+	}
+}
+
+// KinesisStreamingDestinationStatus fetches the Kinesis streaming destination and its Status
+func KinesisStreamingDestinationStatus(conn *dynamodb.DynamoDB, tableName, streamArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.KinesisDataStreamDestination(conn, tableName, streamArn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DestinationStatus), nil
+	}
+}
@@ -0,0 +1,98 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ContributorInsightsCreatedTimeout = 5 * time.Minute
+	ContributorInsightsDeletedTimeout = 5 * time.Minute
+
+	KinesisStreamingDestinationActiveTimeout   = 5 * time.Minute
+	KinesisStreamingDestinationDisabledTimeout = 5 * time.Minute
+)
+
+// ContributorInsightsEnabled waits for Contributor Insights to return Enabled
+func ContributorInsightsEnabled(conn *dynamodb.DynamoDB, tableName, indexName string, timeout time.Duration) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusEnabling},
+		Target:  []string{dynamodb.ContributorInsightsStatusEnabled},
+		Refresh: ContributorInsightsStatus(conn, tableName, indexName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		if aws.StringValue(output.ContributorInsightsStatus) == dynamodb.ContributorInsightsStatusFailed && output.FailureException != nil {
+			err = fmt.Errorf("%w: %s: %s", err, aws.StringValue(output.FailureException.ExceptionName), aws.StringValue(output.FailureException.ExceptionDescription))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ContributorInsightsDisabled waits for Contributor Insights to return Disabled
+func ContributorInsightsDisabled(conn *dynamodb.DynamoDB, tableName, indexName string, timeout time.Duration) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusDisabling, dynamodb.ContributorInsightsStatusEnabled},
+		Target:  []string{dynamodb.ContributorInsightsStatusDisabled},
+		Refresh: ContributorInsightsStatus(conn, tableName, indexName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// KinesisStreamingDestinationActive waits for a Kinesis streaming destination to return Active
+func KinesisStreamingDestinationActive(conn *dynamodb.DynamoDB, tableName, streamArn string, timeout time.Duration) (*dynamodb.KinesisDataStreamDestination, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.DestinationStatusDisabled, dynamodb.DestinationStatusEnabling},
+		Target:  []string{dynamodb.DestinationStatusActive},
+		Refresh: KinesisStreamingDestinationStatus(conn, tableName, streamArn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.KinesisDataStreamDestination); ok {
+		if aws.StringValue(output.DestinationStatus) == dynamodb.DestinationStatusEnableFailed {
+			err = fmt.Errorf("%w: %s", err, aws.StringValue(output.DestinationStatusDescription))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// KinesisStreamingDestinationDisabled waits for a Kinesis streaming destination to return Disabled
+func KinesisStreamingDestinationDisabled(conn *dynamodb.DynamoDB, tableName, streamArn string, timeout time.Duration) (*dynamodb.KinesisDataStreamDestination, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.DestinationStatusActive, dynamodb.DestinationStatusDisabling},
+		Target:  []string{dynamodb.DestinationStatusDisabled},
+		Refresh: KinesisStreamingDestinationStatus(conn, tableName, streamArn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.KinesisDataStreamDestination); ok {
+		return output, err
+	}
+
+	return nil, err
+}
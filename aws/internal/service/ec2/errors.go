@@ -16,6 +16,14 @@ const (
 	ErrCodeInvalidCarrierGatewayIDNotFound = "InvalidCarrierGatewayID.NotFound"
 )
 
+const (
+	ErrCodeInvalidHostIDNotFound = "InvalidHostID.NotFound"
+)
+
+const (
+	ErrCodeInvalidInstanceIDNotFound = "InvalidInstanceID.NotFound"
+)
+
 const (
 	ErrCodeInvalidPrefixListIDNotFound = "InvalidPrefixListID.NotFound"
 )
@@ -210,6 +210,74 @@ func ClientVpnRouteStatus(conn *ec2.EC2, routeID string) resource.StateRefreshFu
 	}
 }
 
+const (
+	HostStatusNotFound = "NotFound"
+
+	HostStatusUnknown = "Unknown"
+)
+
+// HostStatus fetches the Dedicated Host and its State
+func HostStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		host, err := finder.HostByID(conn, id)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidHostIDNotFound) {
+			return nil, HostStatusNotFound, nil
+		}
+		if err != nil {
+			return nil, HostStatusUnknown, err
+		}
+
+		if host == nil {
+			return nil, HostStatusNotFound, nil
+		}
+
+		state := aws.StringValue(host.State)
+
+		if state == ec2.AllocationStateReleased || state == ec2.AllocationStateReleasedPermanentFailure {
+			return nil, HostStatusNotFound, nil
+		}
+
+		return host, state, nil
+	}
+}
+
+const (
+	InstanceStatusNotFound = "NotFound"
+
+	InstanceStatusUnknown = "Unknown"
+)
+
+// InstanceStatus fetches the Instance and its State
+func InstanceStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := finder.InstanceByID(conn, id)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidInstanceIDNotFound) {
+			return nil, InstanceStatusNotFound, nil
+		}
+		if err != nil {
+			return nil, InstanceStatusUnknown, err
+		}
+
+		if instance == nil || instance.State == nil {
+			return nil, InstanceStatusNotFound, nil
+		}
+
+		state := aws.StringValue(instance.State.Name)
+
+		if state == ec2.InstanceStateNameTerminated {
+			var reason string
+
+			if instance.StateReason != nil {
+				reason = aws.StringValue(instance.StateReason.Message)
+			}
+
+			return instance, state, fmt.Errorf("instance terminated: %s", reason)
+		}
+
+		return instance, state, nil
+	}
+}
+
 const (
 	SecurityGroupStatusCreated = "Created"
 
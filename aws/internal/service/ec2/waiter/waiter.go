@@ -234,6 +234,122 @@ func ClientVpnRouteDeleted(conn *ec2.EC2, routeID string) (*ec2.ClientVpnRoute,
 	return nil, err
 }
 
+const (
+	HostCreatedTimeout = 10 * time.Minute
+
+	HostUpdatedTimeout = 10 * time.Minute
+
+	HostDeletedTimeout = 20 * time.Minute
+)
+
+// HostCreated waits for a Dedicated Host to return Available
+func HostCreated(conn *ec2.EC2, id string) (*ec2.Host, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Refresh: HostStatus(conn, id),
+		Timeout: HostCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Host); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// HostUpdated waits for a Dedicated Host to return Available after modification
+func HostUpdated(conn *ec2.EC2, id string) (*ec2.Host, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Refresh: HostStatus(conn, id),
+		Timeout: HostUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Host); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// HostDeleted waits for a Dedicated Host to return Released
+func HostDeleted(conn *ec2.EC2, id string) (*ec2.Host, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStateAvailable},
+		Target:  []string{},
+		Refresh: HostStatus(conn, id),
+		Timeout: HostDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Host); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	InstanceRunningTimeout = 10 * time.Minute
+
+	InstanceStoppedTimeout = 10 * time.Minute
+
+	instanceStateDelay      = 10 * time.Second
+	instanceStateMinTimeout = 3 * time.Second
+)
+
+// InstanceRunning waits for an Instance to return Running
+func InstanceRunning(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
+		Target:     []string{ec2.InstanceStateNameRunning},
+		Refresh:    InstanceStatus(conn, id),
+		Timeout:    timeout,
+		Delay:      instanceStateDelay,
+		MinTimeout: instanceStateMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Instance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InstanceStopped waits for an Instance to return Stopped
+func InstanceStopped(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.InstanceStateNamePending,
+			ec2.InstanceStateNameRunning,
+			ec2.InstanceStateNameShuttingDown,
+			ec2.InstanceStateNameStopping,
+		},
+		Target:     []string{ec2.InstanceStateNameStopped},
+		Refresh:    InstanceStatus(conn, id),
+		Timeout:    timeout,
+		Delay:      instanceStateDelay,
+		MinTimeout: instanceStateMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Instance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func SecurityGroupCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.SecurityGroup, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{SecurityGroupStatusNotFound},
@@ -0,0 +1,148 @@
+package finder
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ServiceByID returns the ECS Service corresponding to the specified service name or ARN in the specified cluster.
+// Returns NotFoundError if no service is found.
+func ServiceByID(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) || tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Services[0], nil
+}
+
+// StoppedTaskReasons returns the stopped reasons of the tasks started by the specified ECS Service deployment,
+// looking at no more than the specified number of stopped tasks. ListTasks makes no ordering guarantee,
+// so the tasks examined are not necessarily the most recently stopped. Reasons are ordered most recently stopped first.
+func StoppedTaskReasons(conn *ecs.ECS, cluster, serviceName, deploymentID string, maxResults int) ([]string, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(int64(maxResults)),
+		ServiceName:   aws.String(serviceName),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.ListTasks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: output.TaskArns,
+	}
+
+	if cluster != "" {
+		describeInput.Cluster = aws.String(cluster)
+	}
+
+	describeOutput, err := conn.DescribeTasks(describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, nil
+	}
+
+	tasks := describeOutput.Tasks
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i] == nil || tasks[j] == nil {
+			return tasks[j] == nil && tasks[i] != nil
+		}
+
+		return aws.TimeValue(tasks[i].StoppedAt).After(aws.TimeValue(tasks[j].StoppedAt))
+	})
+
+	var reasons []string
+
+	for _, task := range tasks {
+		if task == nil || aws.StringValue(task.StoppedReason) == "" {
+			continue
+		}
+
+		if deploymentID != "" && aws.StringValue(task.StartedBy) != deploymentID {
+			continue
+		}
+
+		reasons = append(reasons, aws.StringValue(task.StoppedReason))
+	}
+
+	return reasons, nil
+}
+
+// TaskSetByID returns the ECS Task Set corresponding to the specified task set ID.
+// Returns NotFoundError if no task set is found.
+func TaskSetByID(conn *ecs.ECS, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(cluster),
+		Include:  aws.StringSlice([]string{ecs.TaskSetFieldTags}),
+		Service:  aws.String(service),
+		TaskSets: aws.StringSlice([]string{taskSetID}),
+	}
+
+	output, err := conn.DescribeTaskSets(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) ||
+		tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) ||
+		tfawserr.ErrCodeEquals(err, ecs.ErrCodeTaskSetNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskSets) == 0 || output.TaskSets[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.TaskSets[0], nil
+}
@@ -1,9 +1,14 @@
 package waiter
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
@@ -12,6 +17,14 @@ const (
 
 	// EventSubscription Unknown
 	CapacityProviderStatusUnknown = "Unknown"
+
+	ServiceStatusPending = "tfPENDING"
+	ServiceStatusStable  = "tfSTABLE"
+
+	TaskSetStatusNotFound = "NotFound"
+
+	// Number of stopped tasks whose reasons are reported when a deployment fails
+	serviceStoppedTaskReasonsMax = 10
 )
 
 // CapacityProviderStatus fetches the Capacity Provider and its Status
@@ -34,3 +47,80 @@ func CapacityProviderStatus(conn *ecs.ECS, capacityProvider string) resource.Sta
 		return output.CapacityProviders[0], aws.StringValue(output.CapacityProviders[0].Status), nil
 	}
 }
+
+// ServiceStableStatus fetches the ECS Service and reports whether it has reached a steady state.
+// An error describing the failure is returned if any of the service's deployments has failed.
+func ServiceStableStatus(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := finder.ServiceByID(conn, id, cluster)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.StringValue(service.Status); status != "ACTIVE" {
+			return service, status, nil
+		}
+
+		for _, deployment := range service.Deployments {
+			if deployment == nil || aws.StringValue(deployment.RolloutState) != ecs.DeploymentRolloutStateFailed {
+				continue
+			}
+
+			return service, "", serviceDeploymentFailedError(conn, service, deployment)
+		}
+
+		if len(service.Deployments) == 1 && aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount) {
+			return service, ServiceStatusStable, nil
+		}
+
+		return service, ServiceStatusPending, nil
+	}
+}
+
+func serviceDeploymentFailedError(conn *ecs.ECS, service *ecs.Service, deployment *ecs.Deployment) error {
+	deploymentID := aws.StringValue(deployment.Id)
+	err := fmt.Errorf("deployment (%s) failed: %s", deploymentID, aws.StringValue(deployment.RolloutStateReason))
+
+	reasons, reasonsErr := finder.StoppedTaskReasons(conn, aws.StringValue(service.ClusterArn), aws.StringValue(service.ServiceName), deploymentID, serviceStoppedTaskReasonsMax)
+
+	if reasonsErr != nil {
+		return fmt.Errorf("%w (error listing stopped tasks: %s)", err, reasonsErr)
+	}
+
+	if len(reasons) > 0 {
+		return fmt.Errorf("%w; stopped task reasons: %s", err, strings.Join(reasons, "; "))
+	}
+
+	return err
+}
+
+// TaskSetStatus fetches the ECS Task Set and its Status
+func TaskSetStatus(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		taskSet, err := finder.TaskSetByID(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, TaskSetStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return taskSet, aws.StringValue(taskSet.Status), nil
+	}
+}
+
+// TaskSetStabilityStatus fetches the ECS Task Set and its StabilityStatus
+func TaskSetStabilityStatus(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		taskSet, err := finder.TaskSetByID(conn, taskSetID, service, cluster)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return taskSet, aws.StringValue(taskSet.StabilityStatus), nil
+	}
+}
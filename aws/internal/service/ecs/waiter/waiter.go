@@ -10,6 +10,15 @@ import (
 const (
 	// Maximum amount of time to wait for a Capacity Provider to return INACTIVE
 	CapacityProviderInactiveTimeout = 20 * time.Minute
+
+	// Default maximum amount of time to wait for a Service to reach a steady state
+	ServiceStableTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a Task Set to reach a steady state
+	TaskSetStableTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Task Set to be deleted
+	TaskSetDeletedTimeout = 10 * time.Minute
 )
 
 // CapacityProviderInactive waits for a Capacity Provider to return INACTIVE
@@ -29,3 +38,58 @@ func CapacityProviderInactive(conn *ecs.ECS, capacityProvider string) (*ecs.Capa
 
 	return nil, err
 }
+
+// ServiceStable waits for a Service to reach a steady state, failing fast if a deployment fails
+func ServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ServiceStatusPending},
+		Target:     []string{ServiceStatusStable},
+		Refresh:    ServiceStableStatus(conn, id, cluster),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// TaskSetStable waits for a Task Set to reach a steady state
+func TaskSetStable(conn *ecs.ECS, taskSetID, service, cluster string, timeout time.Duration) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.StabilityStatusStabilizing},
+		Target:  []string{ecs.StabilityStatusSteadyState},
+		Refresh: TaskSetStabilityStatus(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// TaskSetDeleted waits for a Task Set to be deleted
+func TaskSetDeleted(conn *ecs.ECS, taskSetID, service, cluster string, timeout time.Duration) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE", "PRIMARY", "DRAINING"},
+		Target:  []string{TaskSetStatusNotFound},
+		Refresh: TaskSetStatus(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,135 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AddonByClusterNameAndAddonName returns the EKS Addon corresponding to the specified cluster and addon names.
+// Returns NotFoundError if no addon is found.
+func AddonByClusterNameAndAddonName(conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
+	input := &eks.DescribeAddonInput{
+		AddonName:   aws.String(addonName),
+		ClusterName: aws.String(clusterName),
+	}
+
+	output, err := conn.DescribeAddon(input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Addon == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Addon, nil
+}
+
+// AddonUpdateByClusterNameAddonNameAndID returns the EKS Addon Update corresponding to the specified cluster name, addon name and update ID.
+// Returns NotFoundError if no update is found.
+func AddonUpdateByClusterNameAddonNameAndID(conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
+	input := &eks.DescribeUpdateInput{
+		AddonName: aws.String(addonName),
+		Name:      aws.String(clusterName),
+		UpdateId:  aws.String(id),
+	}
+
+	output, err := conn.DescribeUpdate(input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Update == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Update, nil
+}
+
+// AddonVersionByAddonNameAndKubernetesVersion returns the EKS Addon version compatible with the specified Kubernetes version.
+// If mostRecent is false, the default version for the Kubernetes version is returned.
+// Returns NotFoundError if no compatible version is found.
+func AddonVersionByAddonNameAndKubernetesVersion(conn *eks.EKS, addonName, kubernetesVersion string, mostRecent bool) (*eks.AddonVersionInfo, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: aws.String(kubernetesVersion),
+	}
+	var version *eks.AddonVersionInfo
+
+	err := conn.DescribeAddonVersionsPages(input, func(page *eks.DescribeAddonVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, addon := range page.Addons {
+			if addon == nil || aws.StringValue(addon.AddonName) != addonName {
+				continue
+			}
+
+			for i, addonVersion := range addon.AddonVersions {
+				if addonVersion == nil {
+					continue
+				}
+
+				// Versions are returned most recent first.
+				if mostRecent && i == 0 {
+					version = addonVersion
+					return false
+				}
+
+				for _, versionCompatibility := range addonVersion.Compatibilities {
+					if versionCompatibility != nil && aws.BoolValue(versionCompatibility.DefaultVersion) {
+						version = addonVersion
+						return false
+					}
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if version == nil || version.AddonVersion == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return version, nil
+}
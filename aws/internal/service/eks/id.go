@@ -0,0 +1,25 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+)
+
+const addonResourceIDSeparator = ":"
+
+func AddonCreateResourceID(clusterName, addonName string) string {
+	parts := []string{clusterName, addonName}
+	id := strings.Join(parts, addonResourceIDSeparator)
+
+	return id
+}
+
+func AddonParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, addonResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]saddon-name", id, addonResourceIDSeparator)
+}
@@ -0,0 +1,43 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// AddonStatus fetches the Addon and its Status
+func AddonStatus(conn *eks.EKS, clusterName, addonName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.AddonByClusterNameAndAddonName(conn, clusterName, addonName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// AddonUpdateStatus fetches the Addon Update and its Status
+func AddonUpdateStatus(conn *eks.EKS, clusterName, addonName, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.AddonUpdateByClusterNameAddonNameAndID(conn, clusterName, addonName, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
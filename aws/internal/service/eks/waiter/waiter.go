@@ -0,0 +1,143 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	AddonCreatedTimeout = 20 * time.Minute
+	AddonUpdatedTimeout = 20 * time.Minute
+	AddonDeletedTimeout = 40 * time.Minute
+)
+
+// AddonCreated waits for an Addon to return Active
+func AddonCreated(conn *eks.EKS, clusterName, addonName string, timeout time.Duration) (*eks.Addon, error) {
+	stateConf := resource.StateChangeConf{
+		Pending: []string{eks.AddonStatusCreating},
+		Target:  []string{eks.AddonStatusActive},
+		Refresh: AddonStatus(conn, clusterName, addonName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Addon); ok {
+		if status := aws.StringValue(output.Status); status == eks.AddonStatusCreateFailed || status == eks.AddonStatusDegraded {
+			if err != nil {
+				err = fmt.Errorf("%w: %s", err, AddonIssuesError(output.Health))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AddonActive waits for an Addon to return Active or Degraded following a modification.
+// A Degraded Addon is returned along with an error describing its health issues.
+func AddonActive(conn *eks.EKS, clusterName, addonName string, timeout time.Duration) (*eks.Addon, error) {
+	stateConf := resource.StateChangeConf{
+		Pending: []string{eks.AddonStatusCreating, eks.AddonStatusUpdating},
+		Target:  []string{eks.AddonStatusActive, eks.AddonStatusDegraded},
+		Refresh: AddonStatus(conn, clusterName, addonName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Addon); ok {
+		if err == nil && aws.StringValue(output.Status) == eks.AddonStatusDegraded {
+			err = fmt.Errorf("addon is %s: %w", eks.AddonStatusDegraded, AddonIssuesError(output.Health))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AddonDeleted waits for an Addon to be deleted
+func AddonDeleted(conn *eks.EKS, clusterName, addonName string, timeout time.Duration) (*eks.Addon, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.AddonStatusActive, eks.AddonStatusDegraded, eks.AddonStatusDeleting},
+		Target:  []string{},
+		Refresh: AddonStatus(conn, clusterName, addonName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Addon); ok {
+		if aws.StringValue(output.Status) == eks.AddonStatusDeleteFailed {
+			if err != nil {
+				err = fmt.Errorf("%w: %s", err, AddonIssuesError(output.Health))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AddonUpdateSuccessful waits for an Addon Update to return Successful
+func AddonUpdateSuccessful(conn *eks.EKS, clusterName, addonName, id string, timeout time.Duration) (*eks.Update, error) {
+	stateConf := resource.StateChangeConf{
+		Pending: []string{eks.UpdateStatusInProgress},
+		Target:  []string{eks.UpdateStatusSuccessful},
+		Refresh: AddonUpdateStatus(conn, clusterName, addonName, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Update); ok {
+		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
+			var errs *multierror.Error
+
+			for _, e := range output.Errors {
+				if e == nil {
+					continue
+				}
+
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(e.ErrorCode), aws.StringValue(e.ErrorMessage)))
+			}
+
+			if errs != nil {
+				err = fmt.Errorf("%w: %s", err, errs)
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AddonIssuesError returns an error describing the health issues reported for an Addon.
+func AddonIssuesError(health *eks.AddonHealth) error {
+	if health == nil || len(health.Issues) == 0 {
+		return errors.New("no health issues reported")
+	}
+
+	var errs *multierror.Error
+
+	for _, issue := range health.Issues {
+		if issue == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s (resources: %s)", aws.StringValue(issue.Code), aws.StringValue(issue.Message), strings.Join(aws.StringValueSlice(issue.ResourceIds), ", ")))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -82,3 +82,46 @@ func CacheClusterStatus(conn *elasticache.ElastiCache, cacheClusterID string) re
 		return c, aws.StringValue(c.CacheClusterStatus), nil
 	}
 }
+
+const (
+	GlobalReplicationGroupStatusAvailable   = "available"
+	GlobalReplicationGroupStatusCreating    = "creating"
+	GlobalReplicationGroupStatusModifying   = "modifying"
+	GlobalReplicationGroupStatusPrimaryOnly = "primary-only"
+	GlobalReplicationGroupStatusDeleting    = "deleting"
+	GlobalReplicationGroupStatusDeleted     = "deleted"
+)
+
+// GlobalReplicationGroupStatus fetches the Global Replication Group and its Status
+func GlobalReplicationGroupStatus(conn *elasticache.ElastiCache, globalReplicationGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		grg, err := finder.GlobalReplicationGroupByID(conn, globalReplicationGroupID)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return grg, aws.StringValue(grg.Status), nil
+	}
+}
+
+const (
+	GlobalReplicationGroupMemberStatusAssociated = "associated"
+)
+
+// GlobalReplicationGroupMemberStatus fetches a Global Replication Group Member and its Status
+func GlobalReplicationGroupMemberStatus(conn *elasticache.ElastiCache, globalReplicationGroupID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return member, aws.StringValue(member.Status), nil
+	}
+}
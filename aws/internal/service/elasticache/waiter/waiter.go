@@ -145,3 +145,95 @@ func CacheClusterDeleted(conn *elasticache.ElastiCache, cacheClusterID string, t
 	}
 	return nil, err
 }
+
+const (
+	GlobalReplicationGroupDefaultCreatedTimeout = 20 * time.Minute
+	GlobalReplicationGroupDefaultUpdatedTimeout = 40 * time.Minute
+	GlobalReplicationGroupDefaultDeletedTimeout = 20 * time.Minute
+
+	globalReplicationGroupAvailableMinTimeout = 10 * time.Second
+	globalReplicationGroupAvailableDelay      = 30 * time.Second
+
+	globalReplicationGroupDeletedMinTimeout = 10 * time.Second
+	globalReplicationGroupDeletedDelay      = 30 * time.Second
+
+	globalReplicationGroupDisassociationReadyTimeout = 45 * time.Minute
+
+	globalReplicationGroupMemberDetachedTimeout    = 10 * time.Minute
+	globalReplicationGroupMemberDetachedMinTimeout = 10 * time.Second
+	globalReplicationGroupMemberDetachedDelay      = 30 * time.Second
+)
+
+// GlobalReplicationGroupAvailable waits for a Global Replication Group to be available,
+// with status either "available" or "primary-only"
+func GlobalReplicationGroupAvailable(conn *elasticache.ElastiCache, globalReplicationGroupID string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupStatusCreating,
+			GlobalReplicationGroupStatusModifying,
+		},
+		Target: []string{
+			GlobalReplicationGroupStatusAvailable,
+			GlobalReplicationGroupStatusPrimaryOnly,
+		},
+		Refresh:    GlobalReplicationGroupStatus(conn, globalReplicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupAvailableMinTimeout,
+		Delay:      globalReplicationGroupAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// GlobalReplicationGroupDeleted waits for a Global Replication Group to be deleted
+func GlobalReplicationGroupDeleted(conn *elasticache.ElastiCache, globalReplicationGroupID string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupStatusAvailable,
+			GlobalReplicationGroupStatusPrimaryOnly,
+			GlobalReplicationGroupStatusModifying,
+			GlobalReplicationGroupStatusDeleting,
+		},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupStatus(conn, globalReplicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupDeletedMinTimeout,
+		Delay:      globalReplicationGroupDeletedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// GlobalReplicationGroupDisassociationReady waits for a Global Replication Group to be ready for
+// a member to be disassociated. Members cannot be removed while the group is being modified.
+func GlobalReplicationGroupDisassociationReady(conn *elasticache.ElastiCache, globalReplicationGroupID string) (*elasticache.GlobalReplicationGroup, error) {
+	return GlobalReplicationGroupAvailable(conn, globalReplicationGroupID, globalReplicationGroupDisassociationReadyTimeout)
+}
+
+// GlobalReplicationGroupMemberDetached waits for a Replication Group to be detached from a Global Replication Group
+func GlobalReplicationGroupMemberDetached(conn *elasticache.ElastiCache, globalReplicationGroupID, id string) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupMemberStatusAssociated,
+		},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupMemberStatus(conn, globalReplicationGroupID, id),
+		Timeout:    globalReplicationGroupMemberDetachedTimeout,
+		MinTimeout: globalReplicationGroupMemberDetachedMinTimeout,
+		Delay:      globalReplicationGroupMemberDetachedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+	return nil, err
+}
@@ -0,0 +1,53 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// StreamConsumerByARN returns the stream consumer corresponding to the specified consumer ARN.
+// Returns NotFoundError if no consumer is found.
+func StreamConsumerByARN(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
+	input := &kinesis.DescribeStreamConsumerInput{
+		ConsumerARN: aws.String(arn),
+	}
+
+	return streamConsumer(conn, input)
+}
+
+// StreamConsumerByStreamARNAndName returns the stream consumer with the specified name registered with the specified stream.
+// Returns NotFoundError if no consumer is found.
+func StreamConsumerByStreamARNAndName(conn *kinesis.Kinesis, streamARN, name string) (*kinesis.ConsumerDescription, error) {
+	input := &kinesis.DescribeStreamConsumerInput{
+		ConsumerName: aws.String(name),
+		StreamARN:    aws.String(streamARN),
+	}
+
+	return streamConsumer(conn, input)
+}
+
+func streamConsumer(conn *kinesis.Kinesis, input *kinesis.DescribeStreamConsumerInput) (*kinesis.ConsumerDescription, error) {
+	output, err := conn.DescribeStreamConsumer(input)
+
+	if tfawserr.ErrCodeEquals(err, kinesis.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConsumerDescription == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ConsumerDescription, nil
+}
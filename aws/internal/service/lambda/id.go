@@ -0,0 +1,39 @@
+package lambda
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const layerVersionPermissionResourceIDSeparator = ","
+
+func LayerVersionPermissionCreateResourceID(layerName string, versionNumber int64, statementID string) string {
+	parts := []string{layerName, strconv.FormatInt(versionNumber, 10), statementID}
+	id := strings.Join(parts, layerVersionPermissionResourceIDSeparator)
+
+	return id
+}
+
+// LayerVersionPermissionParseResourceID parses a layer version permission ID.
+// The statement ID may be omitted, in which case an empty string is returned for it.
+func LayerVersionPermissionParseResourceID(id string) (string, int64, string, error) {
+	parts := strings.Split(id, layerVersionPermissionResourceIDSeparator)
+
+	if (len(parts) == 2 || (len(parts) == 3 && parts[2] != "")) && parts[0] != "" && parts[1] != "" {
+		versionNumber, err := strconv.ParseInt(parts[1], 10, 64)
+
+		if err != nil {
+			return "", 0, "", fmt.Errorf("unexpected format for ID (%[1]s), version-number must be an integer: %[2]w", id, err)
+		}
+
+		var statementID string
+		if len(parts) == 3 {
+			statementID = parts[2]
+		}
+
+		return parts[0], versionNumber, statementID, nil
+	}
+
+	return "", 0, "", fmt.Errorf("unexpected format for ID (%[1]s), expected layer-name%[2]sversion-number or layer-name%[2]sversion-number%[2]sstatement-id", id, layerVersionPermissionResourceIDSeparator)
+}
@@ -0,0 +1,91 @@
+package lambda
+
+import (
+	"testing"
+)
+
+func TestLayerVersionPermissionParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		InputID               string
+		ExpectError           bool
+		ExpectedLayerName     string
+		ExpectedVersionNumber int64
+		ExpectedStatementID   string
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "layer name only",
+			InputID:     "example",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing layer name",
+			InputID:     ",1",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing version number",
+			InputID:     "example,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "invalid version number",
+			InputID:     "example,one",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty statement ID",
+			InputID:     "example,1,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "example,1,statement,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:              "layer name and version number",
+			InputID:               "example,1",
+			ExpectedLayerName:     "example",
+			ExpectedVersionNumber: 1,
+		},
+		{
+			TestName:              "layer name, version number and statement ID",
+			InputID:               "example,12,statement",
+			ExpectedLayerName:     "example",
+			ExpectedVersionNumber: 12,
+			ExpectedStatementID:   "statement",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotLayerName, gotVersionNumber, gotStatementID, err := LayerVersionPermissionParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotLayerName != testCase.ExpectedLayerName {
+				t.Errorf("got layer name %s, expected %s", gotLayerName, testCase.ExpectedLayerName)
+			}
+
+			if gotVersionNumber != testCase.ExpectedVersionNumber {
+				t.Errorf("got version number %d, expected %d", gotVersionNumber, testCase.ExpectedVersionNumber)
+			}
+
+			if gotStatementID != testCase.ExpectedStatementID {
+				t.Errorf("got statement ID %s, expected %s", gotStatementID, testCase.ExpectedStatementID)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package organizations
+
+import (
+	"fmt"
+	"strings"
+)
+
+const delegatedAdministratorResourceIDSeparator = "/"
+
+func DelegatedAdministratorCreateResourceID(accountID, servicePrincipal string) string {
+	parts := []string{accountID, servicePrincipal}
+	id := strings.Join(parts, delegatedAdministratorResourceIDSeparator)
+
+	return id
+}
+
+func DelegatedAdministratorParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, delegatedAdministratorResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sservice-principal", id, delegatedAdministratorResourceIDSeparator)
+}
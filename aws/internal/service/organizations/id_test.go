@@ -0,0 +1,69 @@
+package organizations
+
+import (
+	"testing"
+)
+
+func TestDelegatedAdministratorParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                 string
+		InputID                  string
+		ExpectError              bool
+		ExpectedAccountID        string
+		ExpectedServicePrincipal string
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "account ID only",
+			InputID:     "123456789012",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing account ID",
+			InputID:     "/config.amazonaws.com",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing service principal",
+			InputID:     "123456789012/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "123456789012/config.amazonaws.com/extra",
+			ExpectError: true,
+		},
+		{
+			TestName:                 "valid",
+			InputID:                  "123456789012/config.amazonaws.com",
+			ExpectedAccountID:        "123456789012",
+			ExpectedServicePrincipal: "config.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAccountID, gotServicePrincipal, err := DelegatedAdministratorParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotAccountID != testCase.ExpectedAccountID {
+				t.Errorf("expected account ID %q, got %q", testCase.ExpectedAccountID, gotAccountID)
+			}
+
+			if gotServicePrincipal != testCase.ExpectedServicePrincipal {
+				t.Errorf("expected service principal %q, got %q", testCase.ExpectedServicePrincipal, gotServicePrincipal)
+			}
+		})
+	}
+}
@@ -3,8 +3,66 @@ package finder
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+// DBClusterByID returns the DB Cluster corresponding to the specified identifier.
+// Returns NotFoundError if no DB Cluster is found.
+func DBClusterByID(conn *rds.RDS, id string) (*rds.DBCluster, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dbCluster := range output.DBClusters {
+		if dbCluster == nil {
+			continue
+		}
+
+		if aws.StringValue(dbCluster.DBClusterIdentifier) == id {
+			return dbCluster, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
+
+// DBClusterRoleByDBClusterIDAndRoleARN returns the DB Cluster IAM role association for the specified cluster and role.
+// Returns NotFoundError if the DB Cluster or the association is not found.
+func DBClusterRoleByDBClusterIDAndRoleARN(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+	dbCluster, err := DBClusterByID(conn, dbClusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, associatedRole := range dbCluster.AssociatedRoles {
+		if aws.StringValue(associatedRole.RoleArn) == roleARN {
+			return associatedRole, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: "DB Cluster IAM role association not found",
+	}
+}
+
 // DBProxyTarget returns matching DBProxyTarget.
 func DBProxyTarget(conn *rds.RDS, dbProxyName string, targetGroupName string, targetType string, rdsResourceId string) (*rds.DBProxyTarget, error) {
 	input := &rds.DescribeDBProxyTargetsInput{
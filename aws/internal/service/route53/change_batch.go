@@ -0,0 +1,80 @@
+package route53
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// Limits on the contents of a single ChangeResourceRecordSets request.
+// https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets
+const (
+	ChangeBatchMaxChanges         = 1000
+	ChangeBatchMaxResourceRecords = 1000
+	ChangeBatchMaxValueCharacters = 32000
+)
+
+// SplitChanges splits the specified changes into batches that each fit within
+// the ChangeResourceRecordSets request limits, preserving the order of the changes.
+// UPSERT changes count twice towards the resource record and character limits.
+// A single change that exceeds the limits on its own is placed in a batch by itself.
+func SplitChanges(changes []*route53.Change) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var batchRecords, batchCharacters int
+
+	for _, change := range changes {
+		if change == nil {
+			continue
+		}
+
+		records, characters := changeSize(change)
+
+		if len(batch) > 0 && (len(batch)+1 > ChangeBatchMaxChanges ||
+			batchRecords+records > ChangeBatchMaxResourceRecords ||
+			batchCharacters+characters > ChangeBatchMaxValueCharacters) {
+			batches = append(batches, batch)
+			batch = nil
+			batchRecords = 0
+			batchCharacters = 0
+		}
+
+		batch = append(batch, change)
+		batchRecords += records
+		batchCharacters += characters
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// changeSize returns the number of resource record elements and the number of
+// value characters that the specified change counts for towards the request limits.
+func changeSize(change *route53.Change) (int, int) {
+	var records, characters int
+
+	if rrs := change.ResourceRecordSet; rrs != nil {
+		for _, rr := range rrs.ResourceRecords {
+			if rr == nil {
+				continue
+			}
+
+			records++
+			characters += len(aws.StringValue(rr.Value))
+		}
+	}
+
+	// Alias records have no resource record elements but still count as one change.
+	if records == 0 {
+		records = 1
+	}
+
+	if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+		records *= 2
+		characters *= 2
+	}
+
+	return records, characters
+}
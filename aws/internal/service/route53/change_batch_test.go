@@ -0,0 +1,100 @@
+package route53
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func testChange(action string, values ...string) *route53.Change {
+	rrs := &route53.ResourceRecordSet{
+		Name: aws.String("test.example.com"),
+		Type: aws.String(route53.RRTypeTxt),
+	}
+
+	for _, value := range values {
+		rrs.ResourceRecords = append(rrs.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+	}
+
+	return &route53.Change{
+		Action:            aws.String(action),
+		ResourceRecordSet: rrs,
+	}
+}
+
+func testChanges(n int, action string, values ...string) []*route53.Change {
+	changes := make([]*route53.Change, n)
+
+	for i := range changes {
+		changes[i] = testChange(action, values...)
+	}
+
+	return changes
+}
+
+func TestSplitChanges(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Changes       []*route53.Change
+		ExpectedSizes []int
+	}{
+		{
+			TestName:      "empty",
+			Changes:       nil,
+			ExpectedSizes: nil,
+		},
+		{
+			TestName:      "single",
+			Changes:       testChanges(1, route53.ChangeActionCreate, "a"),
+			ExpectedSizes: []int{1},
+		},
+		{
+			TestName:      "exactly max changes",
+			Changes:       testChanges(1000, route53.ChangeActionDelete, "a"),
+			ExpectedSizes: []int{1000},
+		},
+		{
+			TestName:      "more than max changes",
+			Changes:       testChanges(2500, route53.ChangeActionDelete, "a"),
+			ExpectedSizes: []int{1000, 1000, 500},
+		},
+		{
+			TestName:      "upsert counts double",
+			Changes:       testChanges(600, route53.ChangeActionUpsert, "a"),
+			ExpectedSizes: []int{500, 100},
+		},
+		{
+			TestName:      "resource record limit",
+			Changes:       testChanges(300, route53.ChangeActionCreate, "a", "b", "c", "d"),
+			ExpectedSizes: []int{250, 50},
+		},
+		{
+			TestName:      "value character limit",
+			Changes:       testChanges(5, route53.ChangeActionCreate, strings.Repeat("x", 10000)),
+			ExpectedSizes: []int{3, 2},
+		},
+		{
+			TestName:      "oversized change",
+			Changes:       testChanges(2, route53.ChangeActionUpsert, strings.Repeat("x", 20000)),
+			ExpectedSizes: []int{1, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := SplitChanges(testCase.Changes)
+
+			if len(got) != len(testCase.ExpectedSizes) {
+				t.Fatalf("expected %d batches, got %d", len(testCase.ExpectedSizes), len(got))
+			}
+
+			for i, batch := range got {
+				if len(batch) != testCase.ExpectedSizes[i] {
+					t.Errorf("expected batch %d to have %d changes, got %d", i, testCase.ExpectedSizes[i], len(batch))
+				}
+			}
+		})
+	}
+}
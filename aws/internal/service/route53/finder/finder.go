@@ -48,3 +48,34 @@ func KeySigningKeyByResourceID(conn *route53.Route53, resourceID string) (*route
 
 	return KeySigningKey(conn, hostedZoneID, name)
 }
+
+// ResourceRecordSets returns all the resource record sets in the specified hosted zone.
+func ResourceRecordSets(conn *route53.Route53, hostedZoneID string) ([]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	var result []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPages(input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, resourceRecordSet := range page.ResourceRecordSets {
+			if resourceRecordSet == nil {
+				continue
+			}
+
+			result = append(result, resourceRecordSet)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -23,3 +23,30 @@ func KeySigningKeyParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected hosted-zone-id%[2]sname", id, KeySigningKeyResourceIDSeparator)
 }
+
+const RecordsResourceIDSeparator = ","
+
+func RecordsCreateResourceID(hostedZoneID, nameSuffix string) string {
+	if nameSuffix == "" {
+		return hostedZoneID
+	}
+
+	parts := []string{hostedZoneID, nameSuffix}
+	id := strings.Join(parts, RecordsResourceIDSeparator)
+
+	return id
+}
+
+func RecordsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, RecordsResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected hosted-zone-id or hosted-zone-id%[2]sname-suffix", id, RecordsResourceIDSeparator)
+}
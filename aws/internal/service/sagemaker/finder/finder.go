@@ -157,3 +157,79 @@ func AppImageConfigByName(conn *sagemaker.SageMaker, appImageConfigID string) (*
 
 	return output, nil
 }
+
+// FlowDefinitionByName returns the flow definition corresponding to the specified name.
+// Returns nil if no flow definition is found.
+func FlowDefinitionByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeFlowDefinitionOutput, error) {
+	input := &sagemaker.DescribeFlowDefinitionInput{
+		FlowDefinitionName: aws.String(name),
+	}
+
+	output, err := conn.DescribeFlowDefinition(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// HumanTaskUiByName returns the human task UI corresponding to the specified name.
+// Returns nil if no human task UI is found.
+func HumanTaskUiByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeHumanTaskUiOutput, error) {
+	input := &sagemaker.DescribeHumanTaskUiInput{
+		HumanTaskUiName: aws.String(name),
+	}
+
+	output, err := conn.DescribeHumanTaskUi(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// WorkforceByName returns the workforce corresponding to the specified name.
+// Returns nil if no workforce is found.
+func WorkforceByName(conn *sagemaker.SageMaker, name string) (*sagemaker.Workforce, error) {
+	input := &sagemaker.DescribeWorkforceInput{
+		WorkforceName: aws.String(name),
+	}
+
+	output, err := conn.DescribeWorkforce(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Workforce, nil
+}
+
+// WorkteamByName returns the workteam corresponding to the specified name.
+// Returns nil if no workteam is found.
+func WorkteamByName(conn *sagemaker.SageMaker, name string) (*sagemaker.Workteam, error) {
+	input := &sagemaker.DescribeWorkteamInput{
+		WorkteamName: aws.String(name),
+	}
+
+	output, err := conn.DescribeWorkteam(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Workteam, nil
+}
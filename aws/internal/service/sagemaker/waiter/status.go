@@ -21,6 +21,10 @@ const (
 	SagemakerFeatureGroupStatusUnknown       = "Unknown"
 	SagemakerUserProfileStatusNotFound       = "NotFound"
 	SagemakerModelPackageGroupStatusNotFound = "NotFound"
+	SagemakerFlowDefinitionStatusNotFound    = "NotFound"
+	SagemakerFlowDefinitionStatusUnknown     = "Unknown"
+	SagemakerHumanTaskUiStatusNotFound       = "NotFound"
+	SagemakerHumanTaskUiStatusUnknown        = "Unknown"
 )
 
 // NotebookInstanceStatus fetches the NotebookInstance and its Status
@@ -201,3 +205,49 @@ func UserProfileStatus(conn *sagemaker.SageMaker, domainID, userProfileName stri
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+// FlowDefinitionStatus fetches the Flow Definition and its Status
+func FlowDefinitionStatus(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.FlowDefinitionByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			return nil, SagemakerFlowDefinitionStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, SagemakerFlowDefinitionStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, SagemakerFlowDefinitionStatusNotFound, nil
+		}
+
+		if status := aws.StringValue(output.FlowDefinitionStatus); status == sagemaker.FlowDefinitionStatusFailed {
+			return output, status, fmt.Errorf("%s", aws.StringValue(output.FailureReason))
+		}
+
+		return output, aws.StringValue(output.FlowDefinitionStatus), nil
+	}
+}
+
+// HumanTaskUiStatus fetches the Human Task UI and its Status
+func HumanTaskUiStatus(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.HumanTaskUiByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			return nil, SagemakerHumanTaskUiStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, SagemakerHumanTaskUiStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, SagemakerHumanTaskUiStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.HumanTaskUiStatus), nil
+	}
+}
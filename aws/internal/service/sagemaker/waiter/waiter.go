@@ -23,6 +23,9 @@ const (
 	FeatureGroupDeletedTimeout        = 10 * time.Minute
 	UserProfileInServiceTimeout       = 10 * time.Minute
 	UserProfileDeletedTimeout         = 10 * time.Minute
+	FlowDefinitionActiveTimeout       = 2 * time.Minute
+	FlowDefinitionDeletedTimeout      = 2 * time.Minute
+	HumanTaskUiDeletedTimeout         = 5 * time.Minute
 )
 
 // NotebookInstanceInService waits for a NotebookInstance to return InService
@@ -325,3 +328,57 @@ func UserProfileDeleted(conn *sagemaker.SageMaker, domainID, userProfileName str
 
 	return nil, err
 }
+
+// FlowDefinitionActive waits for a Flow Definition to return Active
+func FlowDefinitionActive(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeFlowDefinitionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.FlowDefinitionStatusInitializing},
+		Target:  []string{sagemaker.FlowDefinitionStatusActive},
+		Refresh: FlowDefinitionStatus(conn, name),
+		Timeout: FlowDefinitionActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// FlowDefinitionDeleted waits for a Flow Definition to be deleted
+func FlowDefinitionDeleted(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeFlowDefinitionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.FlowDefinitionStatusDeleting},
+		Target:  []string{},
+		Refresh: FlowDefinitionStatus(conn, name),
+		Timeout: FlowDefinitionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// HumanTaskUiDeleted waits for a Human Task UI to be deleted
+func HumanTaskUiDeleted(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeHumanTaskUiOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.HumanTaskUiStatusDeleting},
+		Target:  []string{},
+		Refresh: HumanTaskUiStatus(conn, name),
+		Timeout: HumanTaskUiDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*sagemaker.DescribeHumanTaskUiOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
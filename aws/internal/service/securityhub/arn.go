@@ -0,0 +1,48 @@
+package securityhub
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	standardsControlARNResourcePrefix      = "control/"
+	standardsSubscriptionARNResourcePrefix = "subscription/"
+)
+
+// StandardsControlARNToStandardsSubscriptionARN converts a security standard control ARN to a subscription ARN.
+// e.g. arn:aws:securityhub:eu-west-1:123456789012:control/cis-aws-foundations-benchmark/v/1.2.0/1.1 ->
+//      arn:aws:securityhub:eu-west-1:123456789012:subscription/cis-aws-foundations-benchmark/v/1.2.0
+func StandardsControlARNToStandardsSubscriptionARN(inputARN string) (string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	if actual, expected := parsedARN.Service, "securityhub"; actual != expected {
+		return "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	if !strings.HasPrefix(parsedARN.Resource, standardsControlARNResourcePrefix) {
+		return "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", standardsControlARNResourcePrefix, inputARN, parsedARN.Resource)
+	}
+
+	resourceParts := strings.Split(strings.TrimPrefix(parsedARN.Resource, standardsControlARNResourcePrefix), "/")
+
+	if len(resourceParts) < 2 {
+		return "", fmt.Errorf("expected at least 2 resource parts in ARN (%s), got: %d", inputARN, len(resourceParts))
+	}
+
+	outputARN := arn.ARN{
+		AccountID: parsedARN.AccountID,
+		Partition: parsedARN.Partition,
+		Region:    parsedARN.Region,
+		Resource:  standardsSubscriptionARNResourcePrefix + strings.Join(resourceParts[:len(resourceParts)-1], "/"),
+		Service:   parsedARN.Service,
+	}.String()
+
+	return outputARN, nil
+}
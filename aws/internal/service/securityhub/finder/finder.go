@@ -30,3 +30,50 @@ func AdminAccount(conn *securityhub.SecurityHub, adminAccountID string) (*securi
 
 	return result, err
 }
+
+func Insight(conn *securityhub.SecurityHub, arn string) (*securityhub.Insight, error) {
+	input := &securityhub.GetInsightsInput{
+		InsightArns: aws.StringSlice([]string{arn}),
+		MaxResults:  aws.Int64(1),
+	}
+
+	output, err := conn.GetInsights(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Insights) == 0 {
+		return nil, nil
+	}
+
+	return output.Insights[0], nil
+}
+
+func StandardsControl(conn *securityhub.SecurityHub, standardsSubscriptionARN, standardsControlARN string) (*securityhub.StandardsControl, error) {
+	input := &securityhub.DescribeStandardsControlsInput{
+		StandardsSubscriptionArn: aws.String(standardsSubscriptionARN),
+	}
+	var result *securityhub.StandardsControl
+
+	err := conn.DescribeStandardsControlsPages(input, func(page *securityhub.DescribeStandardsControlsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, control := range page.Controls {
+			if control == nil {
+				continue
+			}
+
+			if aws.StringValue(control.StandardsControlArn) == standardsControlARN {
+				result = control
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
@@ -290,6 +290,7 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 	id := *res.ImageId
 	d.SetId(id)
 
+	// RegisterImage does not support TagSpecifications, so tags are applied after creation.
 	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
 		if err := keyvaluetags.Ec2CreateTags(client, id, v); err != nil {
 			return fmt.Errorf("error adding tags: %s", err)
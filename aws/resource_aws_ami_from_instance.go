@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
-	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsAmiFromInstance() *schema.Resource {
@@ -191,10 +190,11 @@ func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{})
 	client := meta.(*AWSClient).ec2conn
 
 	req := &ec2.CreateImageInput{
-		Name:        aws.String(d.Get("name").(string)),
-		Description: aws.String(d.Get("description").(string)),
-		InstanceId:  aws.String(d.Get("source_instance_id").(string)),
-		NoReboot:    aws.Bool(d.Get("snapshot_without_reboot").(bool)),
+		Name:              aws.String(d.Get("name").(string)),
+		Description:       aws.String(d.Get("description").(string)),
+		InstanceId:        aws.String(d.Get("source_instance_id").(string)),
+		NoReboot:          aws.Bool(d.Get("snapshot_without_reboot").(bool)),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeImage),
 	}
 
 	res, err := client.CreateImage(req)
@@ -205,12 +205,6 @@ func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(aws.StringValue(res.ImageId))
 	d.Set("manage_ebs_snapshots", true)
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		if err := keyvaluetags.Ec2CreateTags(client, d.Id(), v); err != nil {
-			return fmt.Errorf("error adding tags: %s", err)
-		}
-	}
-
 	_, err = resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), d.Id(), client)
 	if err != nil {
 		return err
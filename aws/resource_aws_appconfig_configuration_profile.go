@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func resourceAwsAppconfigConfigurationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppconfigConfigurationProfileCreate,
+		Read:   resourceAwsAppconfigConfigurationProfileRead,
+		Update: resourceAwsAppconfigConfigurationProfileUpdate,
+		Delete: resourceAwsAppconfigConfigurationProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"location_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"retrieval_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags": tagsSchema(),
+			"validator": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+							ValidateFunc: validation.Any(
+								validation.StringIsJSON,
+								validateArn,
+							),
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appconfig.ValidatorType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppconfigConfigurationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("name").(string)
+	input := &appconfig.CreateConfigurationProfileInput{
+		ApplicationId: aws.String(applicationID),
+		LocationUri:   aws.String(d.Get("location_uri").(string)),
+		Name:          aws.String(name),
+		Tags:          keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().AppconfigTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retrieval_role_arn"); ok {
+		input.RetrievalRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("validator"); ok && v.(*schema.Set).Len() > 0 {
+		input.Validators = expandAppconfigValidators(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating AppConfig Configuration Profile: %s", input)
+	output, err := conn.CreateConfigurationProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppConfig Configuration Profile (%s) for Application (%s): %w", name, applicationID, err)
+	}
+
+	d.SetId(tfappconfig.ConfigurationProfileCreateResourceID(applicationID, aws.StringValue(output.Id)))
+
+	return resourceAwsAppconfigConfigurationProfileRead(d, meta)
+}
+
+func resourceAwsAppconfigConfigurationProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	applicationID, configurationProfileID, err := tfappconfig.ConfigurationProfileParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetConfigurationProfile(&appconfig.GetConfigurationProfileInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppConfig Configuration Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppConfig Configuration Profile (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading AppConfig Configuration Profile (%s): empty response", d.Id())
+	}
+
+	configurationProfileArn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("application/%s/configurationprofile/%s", applicationID, configurationProfileID),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", configurationProfileArn)
+	d.Set("configuration_profile_id", output.Id)
+	d.Set("description", output.Description)
+	d.Set("location_uri", output.LocationUri)
+	d.Set("name", output.Name)
+	d.Set("retrieval_role_arn", output.RetrievalRoleArn)
+
+	if err := d.Set("validator", flattenAppconfigValidators(output.Validators)); err != nil {
+		return fmt.Errorf("error setting validator: %w", err)
+	}
+
+	tags, err := keyvaluetags.AppconfigListTags(conn, configurationProfileArn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppConfig Configuration Profile (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppconfigConfigurationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	if d.HasChangesExcept("tags") {
+		applicationID, configurationProfileID, err := tfappconfig.ConfigurationProfileParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &appconfig.UpdateConfigurationProfileInput{
+			ApplicationId:          aws.String(applicationID),
+			ConfigurationProfileId: aws.String(configurationProfileID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("retrieval_role_arn") {
+			input.RetrievalRoleArn = aws.String(d.Get("retrieval_role_arn").(string))
+		}
+
+		if d.HasChange("validator") {
+			input.Validators = expandAppconfigValidators(d.Get("validator").(*schema.Set).List())
+		}
+
+		log.Printf("[DEBUG] Updating AppConfig Configuration Profile: %s", input)
+		_, err = conn.UpdateConfigurationProfile(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppConfig Configuration Profile (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.AppconfigUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppConfig Configuration Profile (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppconfigConfigurationProfileRead(d, meta)
+}
+
+func resourceAwsAppconfigConfigurationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID, configurationProfileID, err := tfappconfig.ConfigurationProfileParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppConfig Configuration Profile: %s", d.Id())
+	_, err = conn.DeleteConfigurationProfile(&appconfig.DeleteConfigurationProfileInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppConfig Configuration Profile (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAppconfigValidators(l []interface{}) []*appconfig.Validator {
+	validators := make([]*appconfig.Validator, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		validator := &appconfig.Validator{
+			Content: aws.String(tfMap["content"].(string)),
+			Type:    aws.String(tfMap["type"].(string)),
+		}
+
+		validators = append(validators, validator)
+	}
+
+	return validators
+}
+
+func flattenAppconfigValidators(validators []*appconfig.Validator) []interface{} {
+	l := make([]interface{}, 0, len(validators))
+
+	for _, validator := range validators {
+		if validator == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"content": aws.StringValue(validator.Content),
+			"type":    aws.StringValue(validator.Type),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig/waiter"
+)
+
+func resourceAwsAppconfigDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppconfigDeploymentCreate,
+		Read:   resourceAwsAppconfigDeploymentRead,
+		Update: resourceAwsAppconfigDeploymentUpdate,
+		Delete: resourceAwsAppconfigDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"configuration_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"deployment_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"deployment_strategy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^(?:[a-z0-9]{4,7}|AppConfig\.[A-Za-z0-9]{9,40})$`),
+					"must be a deployment strategy ID or the name of a predefined AppConfig deployment strategy",
+				),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAppconfigDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID := d.Get("application_id").(string)
+	environmentID := d.Get("environment_id").(string)
+	input := &appconfig.StartDeploymentInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(d.Get("configuration_profile_id").(string)),
+		ConfigurationVersion:   aws.String(d.Get("configuration_version").(string)),
+		DeploymentStrategyId:   aws.String(d.Get("deployment_strategy_id").(string)),
+		EnvironmentId:          aws.String(environmentID),
+		Tags:                   keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().AppconfigTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting AppConfig Deployment: %s", input)
+	output, err := conn.StartDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting AppConfig Deployment for Application (%s) and Environment (%s): %w", applicationID, environmentID, err)
+	}
+
+	deploymentNumber := aws.Int64Value(output.DeploymentNumber)
+
+	d.SetId(tfappconfig.DeploymentCreateResourceID(applicationID, environmentID, deploymentNumber))
+
+	if _, err := waiter.DeploymentCompleted(conn, applicationID, environmentID, deploymentNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for AppConfig Deployment (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceAwsAppconfigDeploymentRead(d, meta)
+}
+
+func resourceAwsAppconfigDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	applicationID, environmentID, deploymentNumber, err := tfappconfig.DeploymentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.Deployment(conn, applicationID, environmentID, deploymentNumber)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppConfig Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppConfig Deployment (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading AppConfig Deployment (%s): empty response", d.Id())
+	}
+
+	deploymentArn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("application/%s/environment/%s/deployment/%d", applicationID, environmentID, deploymentNumber),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", deploymentArn)
+	d.Set("configuration_profile_id", output.ConfigurationProfileId)
+	d.Set("configuration_version", output.ConfigurationVersion)
+	d.Set("deployment_number", output.DeploymentNumber)
+	d.Set("deployment_strategy_id", output.DeploymentStrategyId)
+	d.Set("description", output.Description)
+	d.Set("environment_id", output.EnvironmentId)
+	d.Set("state", output.State)
+
+	tags, err := keyvaluetags.AppconfigListTags(conn, deploymentArn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppConfig Deployment (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppconfigDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.AppconfigUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppConfig Deployment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppconfigDeploymentRead(d, meta)
+}
+
+func resourceAwsAppconfigDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot destroy AppConfig Deployment. Terraform will remove this resource from the state file, however the deployment will remain in the environment's history.")
+	return nil
+}
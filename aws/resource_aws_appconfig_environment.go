@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func resourceAwsAppconfigEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppconfigEnvironmentCreate,
+		Read:   resourceAwsAppconfigEnvironmentRead,
+		Update: resourceAwsAppconfigEnvironmentUpdate,
+		Delete: resourceAwsAppconfigEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(20, 2048),
+								validateArn,
+							),
+						},
+						"alarm_role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(20, 2048),
+								validateArn,
+							),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAppconfigEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("name").(string)
+	input := &appconfig.CreateEnvironmentInput{
+		ApplicationId: aws.String(applicationID),
+		Name:          aws.String(name),
+		Tags:          keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().AppconfigTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("monitor"); ok && v.(*schema.Set).Len() > 0 {
+		input.Monitors = expandAppconfigEnvironmentMonitors(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating AppConfig Environment: %s", input)
+	output, err := conn.CreateEnvironment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppConfig Environment (%s) for Application (%s): %w", name, applicationID, err)
+	}
+
+	d.SetId(tfappconfig.EnvironmentCreateResourceID(applicationID, aws.StringValue(output.Id)))
+
+	return resourceAwsAppconfigEnvironmentRead(d, meta)
+}
+
+func resourceAwsAppconfigEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	applicationID, environmentID, err := tfappconfig.EnvironmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetEnvironment(&appconfig.GetEnvironmentInput{
+		ApplicationId: aws.String(applicationID),
+		EnvironmentId: aws.String(environmentID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppConfig Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppConfig Environment (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading AppConfig Environment (%s): empty response", d.Id())
+	}
+
+	environmentArn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("application/%s/environment/%s", applicationID, environmentID),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", environmentArn)
+	d.Set("description", output.Description)
+	d.Set("environment_id", output.Id)
+	d.Set("name", output.Name)
+	d.Set("state", output.State)
+
+	if err := d.Set("monitor", flattenAppconfigEnvironmentMonitors(output.Monitors)); err != nil {
+		return fmt.Errorf("error setting monitor: %w", err)
+	}
+
+	tags, err := keyvaluetags.AppconfigListTags(conn, environmentArn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppConfig Environment (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppconfigEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	if d.HasChangesExcept("tags") {
+		applicationID, environmentID, err := tfappconfig.EnvironmentParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &appconfig.UpdateEnvironmentInput{
+			ApplicationId: aws.String(applicationID),
+			EnvironmentId: aws.String(environmentID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("monitor") {
+			input.Monitors = expandAppconfigEnvironmentMonitors(d.Get("monitor").(*schema.Set).List())
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating AppConfig Environment: %s", input)
+		_, err = conn.UpdateEnvironment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppConfig Environment (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.AppconfigUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppConfig Environment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppconfigEnvironmentRead(d, meta)
+}
+
+func resourceAwsAppconfigEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID, environmentID, err := tfappconfig.EnvironmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppConfig Environment: %s", d.Id())
+	_, err = conn.DeleteEnvironment(&appconfig.DeleteEnvironmentInput{
+		ApplicationId: aws.String(applicationID),
+		EnvironmentId: aws.String(environmentID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppConfig Environment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAppconfigEnvironmentMonitors(l []interface{}) []*appconfig.Monitor {
+	monitors := make([]*appconfig.Monitor, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		monitor := &appconfig.Monitor{
+			AlarmArn: aws.String(tfMap["alarm_arn"].(string)),
+		}
+
+		if v, ok := tfMap["alarm_role_arn"].(string); ok && v != "" {
+			monitor.AlarmRoleArn = aws.String(v)
+		}
+
+		monitors = append(monitors, monitor)
+	}
+
+	return monitors
+}
+
+func flattenAppconfigEnvironmentMonitors(monitors []*appconfig.Monitor) []interface{} {
+	l := make([]interface{}, 0, len(monitors))
+
+	for _, monitor := range monitors {
+		if monitor == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"alarm_arn":      aws.StringValue(monitor.AlarmArn),
+			"alarm_role_arn": aws.StringValue(monitor.AlarmRoleArn),
+		})
+	}
+
+	return l
+}
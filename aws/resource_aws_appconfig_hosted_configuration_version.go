@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func resourceAwsAppconfigHostedConfigurationVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppconfigHostedConfigurationVersionCreate,
+		Read:   resourceAwsAppconfigHostedConfigurationVersionRead,
+		Delete: resourceAwsAppconfigHostedConfigurationVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"content": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppconfigHostedConfigurationVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID := d.Get("application_id").(string)
+	configurationProfileID := d.Get("configuration_profile_id").(string)
+	input := &appconfig.CreateHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+		Content:                []byte(d.Get("content").(string)),
+		ContentType:            aws.String(d.Get("content_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating AppConfig Hosted Configuration Version for Application (%s) and Configuration Profile (%s)", applicationID, configurationProfileID)
+	output, err := conn.CreateHostedConfigurationVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppConfig Hosted Configuration Version for Application (%s) and Configuration Profile (%s): %w", applicationID, configurationProfileID, err)
+	}
+
+	d.SetId(tfappconfig.HostedConfigurationVersionCreateResourceID(applicationID, configurationProfileID, aws.Int64Value(output.VersionNumber)))
+
+	return resourceAwsAppconfigHostedConfigurationVersionRead(d, meta)
+}
+
+func resourceAwsAppconfigHostedConfigurationVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID, configurationProfileID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetHostedConfigurationVersion(&appconfig.GetHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+		VersionNumber:          aws.Int64(versionNumber),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppConfig Hosted Configuration Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppConfig Hosted Configuration Version (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading AppConfig Hosted Configuration Version (%s): empty response", d.Id())
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("configuration_profile_id", output.ConfigurationProfileId)
+	d.Set("content", string(output.Content))
+	d.Set("content_type", output.ContentType)
+	d.Set("description", output.Description)
+	d.Set("version_number", output.VersionNumber)
+
+	hostedConfigurationVersionArn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("application/%s/configurationprofile/%s/hostedconfigurationversion/%d", applicationID, configurationProfileID, versionNumber),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set("arn", hostedConfigurationVersionArn)
+
+	return nil
+}
+
+func resourceAwsAppconfigHostedConfigurationVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	applicationID, configurationProfileID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppConfig Hosted Configuration Version: %s", d.Id())
+	_, err = conn.DeleteHostedConfigurationVersion(&appconfig.DeleteHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+		VersionNumber:          aws.Int64(versionNumber),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppConfig Hosted Configuration Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
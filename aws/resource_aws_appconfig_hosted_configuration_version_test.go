@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appconfig_hosted_configuration_version", &resource.Sweeper{
+		Name: "aws_appconfig_hosted_configuration_version",
+		F:    testSweepAppConfigHostedConfigurationVersions,
+	})
+}
+
+func testSweepAppConfigHostedConfigurationVersions(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).appconfigconn
+
+	err = conn.ListApplicationsPages(&appconfig.ListApplicationsInput{}, func(page *appconfig.ListApplicationsOutput, lastPage bool) bool {
+		for _, application := range page.Items {
+			applicationID := aws.StringValue(application.Id)
+
+			profilesInput := &appconfig.ListConfigurationProfilesInput{
+				ApplicationId: application.Id,
+			}
+
+			err := conn.ListConfigurationProfilesPages(profilesInput, func(page *appconfig.ListConfigurationProfilesOutput, lastPage bool) bool {
+				for _, configurationProfile := range page.Items {
+					configurationProfileID := aws.StringValue(configurationProfile.Id)
+
+					versionsInput := &appconfig.ListHostedConfigurationVersionsInput{
+						ApplicationId:          application.Id,
+						ConfigurationProfileId: configurationProfile.Id,
+					}
+
+					err := conn.ListHostedConfigurationVersionsPages(versionsInput, func(page *appconfig.ListHostedConfigurationVersionsOutput, lastPage bool) bool {
+						for _, version := range page.Items {
+							id := tfappconfig.HostedConfigurationVersionCreateResourceID(applicationID, configurationProfileID, aws.Int64Value(version.VersionNumber))
+
+							log.Printf("[INFO] Deleting AppConfig Hosted Configuration Version: %s", id)
+							_, err := conn.DeleteHostedConfigurationVersion(&appconfig.DeleteHostedConfigurationVersionInput{
+								ApplicationId:          version.ApplicationId,
+								ConfigurationProfileId: version.ConfigurationProfileId,
+								VersionNumber:          version.VersionNumber,
+							})
+
+							if err != nil {
+								log.Printf("[ERROR] Error deleting AppConfig Hosted Configuration Version (%s): %s", id, err)
+							}
+						}
+
+						return !lastPage
+					})
+
+					if err != nil {
+						log.Printf("[ERROR] Error listing AppConfig Hosted Configuration Versions for Configuration Profile (%s): %s", configurationProfileID, err)
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				log.Printf("[ERROR] Error listing AppConfig Configuration Profiles for Application (%s): %s", applicationID, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppConfig Hosted Configuration Version sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AppConfig Applications: %w", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSAppConfigHostedConfigurationVersion_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appconfig.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigHostedConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigHostedConfigurationVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_appconfig_application.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_profile_id", "aws_appconfig_configuration_profile.test", "configuration_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "content", "{\"foo\":\"bar\"}"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+					testAccCheckResourceAttrRegionalARNAppConfigHostedConfigurationVersion(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appconfig.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigHostedConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigHostedConfigurationVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppconfigHostedConfigurationVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppConfigHostedConfigurationVersionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appconfig_hosted_configuration_version" {
+			continue
+		}
+
+		applicationID, configurationProfileID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetHostedConfigurationVersion(&appconfig.GetHostedConfigurationVersionInput{
+			ApplicationId:          aws.String(applicationID),
+			ConfigurationProfileId: aws.String(configurationProfileID),
+			VersionNumber:          aws.Int64(versionNumber),
+		})
+
+		if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Hosted Configuration Version (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("AppConfig Hosted Configuration Version (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		applicationID, configurationProfileID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+		output, err := conn.GetHostedConfigurationVersion(&appconfig.GetHostedConfigurationVersionInput{
+			ApplicationId:          aws.String(applicationID),
+			ConfigurationProfileId: aws.String(configurationProfileID),
+			VersionNumber:          aws.Int64(versionNumber),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Hosted Configuration Version (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("AppConfig Hosted Configuration Version (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceAttrRegionalARNAppConfigHostedConfigurationVersion(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", resourceName)
+		}
+
+		applicationID, configurationProfileID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return testAccCheckResourceAttrRegionalARN(resourceName, "arn", "appconfig", fmt.Sprintf("application/%s/configurationprofile/%s/hostedconfigurationversion/%d", applicationID, configurationProfileID, versionNumber))(s)
+	}
+}
+
+func testAccAWSAppConfigHostedConfigurationVersion(rName string) string {
+	return composeConfig(testAccAWSAppConfigConfigurationProfileConfigName(rName), fmt.Sprintf(`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  content = jsonencode({
+    foo = "bar"
+  })
+
+  description = %[1]q
+}
+`, rName))
+}
@@ -9,9 +9,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/budgets"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsBudgetsBudget() *schema.Resource {
@@ -196,15 +196,7 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("failed unmarshalling budget: %v", err)
 	}
 
-	if v, ok := d.GetOk("name"); ok {
-		budget.BudgetName = aws.String(v.(string))
-
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		budget.BudgetName = aws.String(resource.PrefixedUniqueId(v.(string)))
-
-	} else {
-		budget.BudgetName = aws.String(resource.UniqueId())
-	}
+	budget.BudgetName = aws.String(naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string)))
 
 	conn := meta.(*AWSClient).budgetconn
 	var accountID string
@@ -342,6 +334,7 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("name", budget.BudgetName)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(budget.BudgetName)))
 
 	if budget.TimePeriod != nil {
 		d.Set("time_period_end", aws.TimeValue(budget.TimePeriod.End).Format("2006-01-02_15:04"))
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -33,11 +34,38 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"account_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validateAwsAccountId,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateAwsAccountId,
+				ConflictsWith: []string{"deployment_targets"},
+			},
+			"deployment_targets": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organizational_unit_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}|r-[a-z0-9]{4,32})$`), ""),
+							},
+						},
+					},
+				},
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"organizational_unit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"parameter_overrides": {
 				Type:     schema.TypeMap,
@@ -72,11 +100,6 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	accountID := meta.(*AWSClient).accountid
-	if v, ok := d.GetOk("account_id"); ok {
-		accountID = v.(string)
-	}
-
 	region := meta.(*AWSClient).region
 	if v, ok := d.GetOk("region"); ok {
 		region = v.(string)
@@ -85,12 +108,29 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 	stackSetName := d.Get("stack_set_name").(string)
 
 	input := &cloudformation.CreateStackInstancesInput{
-		Accounts:     aws.StringSlice([]string{accountID}),
 		OperationId:  aws.String(resource.UniqueId()),
 		Regions:      aws.StringSlice([]string{region}),
 		StackSetName: aws.String(stackSetName),
 	}
 
+	// The account ID component of the resource ID is replaced by the
+	// organizational unit IDs when deploying to organizational units.
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	if v, ok := d.GetOk("deployment_targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeploymentTargets = expandCloudFormationDeploymentTargets(v.([]interface{})[0].(map[string]interface{}))
+		accountID = strings.Join(aws.StringValueSlice(input.DeploymentTargets.OrganizationalUnitIds), cloudFormationStackSetInstanceOrganizationalUnitIDSeparator)
+	} else {
+		input.Accounts = aws.StringSlice([]string{accountID})
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameter_overrides"); ok {
 		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
@@ -180,6 +220,10 @@ func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta
 		return err
 	}
 
+	if organizationalUnitIDs := cloudFormationStackSetInstanceOrganizationalUnitIDs(accountID); organizationalUnitIDs != nil {
+		return resourceAwsCloudFormationStackSetInstanceReadDeploymentTargets(d, conn, stackSetName, organizationalUnitIDs, region)
+	}
+
 	input := &cloudformation.DescribeStackInstanceInput{
 		StackInstanceAccount: aws.String(accountID),
 		StackInstanceRegion:  aws.String(region),
@@ -212,6 +256,7 @@ func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta
 	stackInstance := output.StackInstance
 
 	d.Set("account_id", stackInstance.Account)
+	d.Set("organizational_unit_id", stackInstance.OrganizationalUnitId)
 
 	if err := d.Set("parameter_overrides", flattenAllCloudFormationParameters(stackInstance.ParameterOverrides)); err != nil {
 		return fmt.Errorf("error setting parameters: %s", err)
@@ -235,13 +280,24 @@ func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, met
 		}
 
 		input := &cloudformation.UpdateStackInstancesInput{
-			Accounts:           aws.StringSlice([]string{accountID}),
 			OperationId:        aws.String(resource.UniqueId()),
 			ParameterOverrides: []*cloudformation.Parameter{},
 			Regions:            aws.StringSlice([]string{region}),
 			StackSetName:       aws.String(stackSetName),
 		}
 
+		if organizationalUnitIDs := cloudFormationStackSetInstanceOrganizationalUnitIDs(accountID); organizationalUnitIDs != nil {
+			input.DeploymentTargets = &cloudformation.DeploymentTargets{
+				OrganizationalUnitIds: aws.StringSlice(organizationalUnitIDs),
+			}
+		} else {
+			input.Accounts = aws.StringSlice([]string{accountID})
+		}
+
+		if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OperationPreferences = expandCloudFormationOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("parameter_overrides"); ok {
 			input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
 		}
@@ -271,13 +327,24 @@ func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, met
 	}
 
 	input := &cloudformation.DeleteStackInstancesInput{
-		Accounts:     aws.StringSlice([]string{accountID}),
 		OperationId:  aws.String(resource.UniqueId()),
 		Regions:      aws.StringSlice([]string{region}),
 		RetainStacks: aws.Bool(d.Get("retain_stack").(bool)),
 		StackSetName: aws.String(stackSetName),
 	}
 
+	if organizationalUnitIDs := cloudFormationStackSetInstanceOrganizationalUnitIDs(accountID); organizationalUnitIDs != nil {
+		input.DeploymentTargets = &cloudformation.DeploymentTargets{
+			OrganizationalUnitIds: aws.StringSlice(organizationalUnitIDs),
+		}
+	} else {
+		input.Accounts = aws.StringSlice([]string{accountID})
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Deleting CloudFormation StackSet Instance: %s", d.Id())
 	output, err := conn.DeleteStackInstances(input)
 
@@ -300,8 +367,85 @@ func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, met
 	return nil
 }
 
+func resourceAwsCloudFormationStackSetInstanceReadDeploymentTargets(d *schema.ResourceData, conn *cloudformation.CloudFormation, stackSetName string, organizationalUnitIDs []string, region string) error {
+	input := &cloudformation.ListStackInstancesInput{
+		StackInstanceRegion: aws.String(region),
+		StackSetName:        aws.String(stackSetName),
+	}
+
+	targets := make(map[string]bool, len(organizationalUnitIDs))
+	for _, organizationalUnitID := range organizationalUnitIDs {
+		targets[organizationalUnitID] = true
+	}
+
+	var found bool
+
+	log.Printf("[DEBUG] Reading CloudFormation StackSet Instance: %s", d.Id())
+	err := conn.ListStackInstancesPages(input, func(page *cloudformation.ListStackInstancesOutput, lastPage bool) bool {
+		for _, summary := range page.Summaries {
+			if summary != nil && targets[aws.StringValue(summary.OrganizationalUnitId)] {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation StackSet Instance (%s): %w", d.Id(), err)
+	}
+
+	if !found {
+		log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("deployment_targets", []interface{}{map[string]interface{}{"organizational_unit_ids": organizationalUnitIDs}}); err != nil {
+		return fmt.Errorf("error setting deployment_targets: %w", err)
+	}
+
+	d.Set("region", region)
+	d.Set("stack_set_name", stackSetName)
+
+	return nil
+}
+
+const cloudFormationStackSetInstanceOrganizationalUnitIDSeparator = "/"
+
+// cloudFormationStackSetInstanceOrganizationalUnitIDs returns the organizational unit IDs
+// encoded in the account component of a resource ID, or nil for a single account.
+func cloudFormationStackSetInstanceOrganizationalUnitIDs(accountOrOrganizationalUnitIDs string) []string {
+	if strings.HasPrefix(accountOrOrganizationalUnitIDs, "ou-") || strings.HasPrefix(accountOrOrganizationalUnitIDs, "r-") {
+		return strings.Split(accountOrOrganizationalUnitIDs, cloudFormationStackSetInstanceOrganizationalUnitIDSeparator)
+	}
+
+	return nil
+}
+
+func expandCloudFormationDeploymentTargets(tfMap map[string]interface{}) *cloudformation.DeploymentTargets {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.DeploymentTargets{}
+
+	if v, ok := tfMap["organizational_unit_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.OrganizationalUnitIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
 func resourceAwsCloudFormationStackSetInstanceParseId(id string) (string, string, string, error) {
-	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected NAME,ACCOUNT,REGION", id)
+	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected NAME,ACCOUNT,REGION or NAME,OU_ID[/OU_ID...],REGION", id)
 
 	parts := strings.SplitN(id, ",", 3)
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
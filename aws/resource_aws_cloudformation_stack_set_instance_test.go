@@ -296,6 +296,41 @@ func TestAccAWSCloudFormationStackSetInstance_RetainStack(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFormationStackSetInstance_DeploymentTargets(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSCloudFormationStackSet(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.0.organizational_unit_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"operation_preferences",
+					"retain_stack",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stackInstance *cloudformation.StackInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -372,6 +407,27 @@ func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) er
 			return err
 		}
 
+		if cloudFormationStackSetInstanceOrganizationalUnitIDs(accountID) != nil {
+			output, err := conn.ListStackInstances(&cloudformation.ListStackInstancesInput{
+				StackInstanceRegion: aws.String(region),
+				StackSetName:        aws.String(stackSetName),
+			})
+
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output != nil && len(output.Summaries) > 0 {
+				return fmt.Errorf("CloudFormation StackSet Instance (%s) still exists", rs.Primary.ID)
+			}
+
+			continue
+		}
+
 		input := &cloudformation.DescribeStackInstanceInput{
 			StackInstanceAccount: aws.String(accountID),
 			StackInstanceRegion:  aws.String(region),
@@ -589,3 +645,35 @@ resource "aws_cloudformation_stack_set_instance" "test" {
 }
 `, retainStack)
 }
+
+func testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_cloudformation_stack_set" "test" {
+  name             = %[1]q
+  permission_model = "SERVICE_MANAGED"
+
+  auto_deployment {
+    enabled = true
+  }
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_name = aws_cloudformation_stack_set.test.name
+
+  deployment_targets {
+    organizational_unit_ids = [data.aws_organizations_organization.test.roots[0].id]
+  }
+
+  operation_preferences {
+    failure_tolerance_percentage = 10
+    max_concurrent_percentage    = 50
+  }
+}
+`, rName, testAccAWSCloudFormationStackSetTemplateBodyVpc(rName))
+}
@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCloudFrontKeyGroup_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "test key group"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontKeyGroup_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontKeyGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontKeyGroup_Comment(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfigComment(rName, "comment 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfigComment(rName, "comment 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontKeyGroup_Items(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfigItems(rName, tlsRsaPublicKeyPem(tlsRsaPrivateKeyPem(2048))),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontKeyGroupExists(r string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Key Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		_, err := conn.GetKeyGroup(&cloudfront.GetKeyGroupInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error retrieving CloudFront Key Group (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFrontKeyGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_key_group" {
+			continue
+		}
+
+		_, err := conn.GetKeyGroup(&cloudfront.GetKeyGroupInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchResource) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Key Group (%s) was not deleted", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudFrontKeyGroupConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  comment     = "test key"
+  encoded_key = file("test-fixtures/cloudfront-public-key.pem")
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCloudFrontKeyGroupConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontKeyGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_key_group" "test" {
+  comment = "test key group"
+  items   = [aws_cloudfront_public_key.test.id]
+  name    = %[1]q
+}
+`, rName))
+}
+
+func testAccAWSCloudFrontKeyGroupConfigComment(rName string, comment string) string {
+	return composeConfig(
+		testAccAWSCloudFrontKeyGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_key_group" "test" {
+  comment = %[2]q
+  items   = [aws_cloudfront_public_key.test.id]
+  name    = %[1]q
+}
+`, rName, comment))
+}
+
+func testAccAWSCloudFrontKeyGroupConfigItems(rName, publicKeyPem string) string {
+	return composeConfig(
+		testAccAWSCloudFrontKeyGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test2" {
+  comment     = "test key"
+  encoded_key = "%[2]s"
+  name        = "%[1]s-2"
+}
+
+resource "aws_cloudfront_key_group" "test" {
+  comment = "test key group"
+  items   = [aws_cloudfront_public_key.test.id, aws_cloudfront_public_key.test2.id]
+  name    = %[1]q
+}
+`, rName, tlsPemEscapeNewlines(publicKeyPem)))
+}
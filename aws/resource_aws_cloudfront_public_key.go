@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsCloudFrontPublicKey() *schema.Resource {
@@ -61,13 +62,11 @@ func resourceAwsCloudFrontPublicKey() *schema.Resource {
 func resourceAwsCloudFrontPublicKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
 
-	if v, ok := d.GetOk("name"); ok {
-		d.Set("name", v.(string))
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		d.Set("name", resource.PrefixedUniqueId(v.(string)))
-	} else {
-		d.Set("name", resource.PrefixedUniqueId("tf-"))
+	namePrefix := d.Get("name_prefix").(string)
+	if namePrefix == "" {
+		namePrefix = "tf-"
 	}
+	d.Set("name", naming.Generate(d.Get("name").(string), namePrefix))
 
 	request := &cloudfront.CreatePublicKeyInput{
 		PublicKeyConfig: expandPublicKeyConfig(d),
@@ -109,6 +108,7 @@ func resourceAwsCloudFrontPublicKeyRead(d *schema.ResourceData, meta interface{}
 
 	d.Set("encoded_key", publicKeyConfig.EncodedKey)
 	d.Set("name", publicKeyConfig.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(publicKeyConfig.Name)))
 	d.Set("comment", publicKeyConfig.Comment)
 	d.Set("caller_reference", publicKeyConfig.CallerReference)
 	d.Set("etag", output.ETag)
@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/waiter"
+)
+
+func resourceAwsCloudWatchEventArchive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventArchiveCreate,
+		Read:   resourceAwsCloudWatchEventArchiveRead,
+		Update: resourceAwsCloudWatchEventArchiveUpdate,
+		Delete: resourceAwsCloudWatchEventArchiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"event_pattern": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateEventPatternValue(),
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v.(string))
+					return json
+				},
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 48),
+					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+				),
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventArchiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+	input := &events.CreateArchiveInput{
+		ArchiveName:    aws.String(name),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		pattern, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return fmt.Errorf("event_pattern contains an invalid JSON: %w", err)
+		}
+		input.EventPattern = aws.String(pattern)
+	}
+
+	if v, ok := d.GetOk("retention_days"); ok {
+		input.RetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Events archive: %s", input)
+	_, err := conn.CreateArchive(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Events archive (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.ArchiveEnabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events archive (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.DescribeArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeArchive(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events archive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events archive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ArchiveArn)
+	d.Set("description", output.Description)
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set("name", output.ArchiveName)
+	d.Set("retention_days", output.RetentionDays)
+
+	if output.EventPattern != nil {
+		pattern, err := structure.NormalizeJsonString(aws.StringValue(output.EventPattern))
+		if err != nil {
+			return fmt.Errorf("event_pattern contains an invalid JSON: %w", err)
+		}
+		d.Set("event_pattern", pattern)
+	} else {
+		d.Set("event_pattern", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventArchiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.UpdateArchiveInput{
+		ArchiveName:   aws.String(d.Id()),
+		Description:   aws.String(d.Get("description").(string)),
+		RetentionDays: aws.Int64(int64(d.Get("retention_days").(int))),
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		pattern, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return fmt.Errorf("event_pattern contains an invalid JSON: %w", err)
+		}
+		input.EventPattern = aws.String(pattern)
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Events archive: %s", input)
+	_, err := conn.UpdateArchive(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudWatch Events archive (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ArchiveEnabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events archive (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[INFO] Deleting CloudWatch Events archive (%s)", d.Id())
+	_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events archive (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
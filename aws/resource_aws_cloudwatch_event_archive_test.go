@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCloudWatchEventArchive_basic(t *testing.T) {
+	var v events.DescribeArchiveOutput
+	archiveName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(archiveName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", archiveName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("archive/%s", archiveName)),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", "aws_cloudwatch_event_bus.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern", ""),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventArchive_disappears(t *testing.T) {
+	var v events.DescribeArchiveOutput
+	archiveName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(archiveName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchEventArchive(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventArchive_update(t *testing.T) {
+	var v events.DescribeArchiveOutput
+	archiveName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(archiveName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig_updateAttributes(archiveName, "test description", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern", "{\"source\":[\"company.team.service\"]}"),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchEventArchiveDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_archive" {
+			continue
+		}
+
+		params := events.DescribeArchiveInput{
+			ArchiveName: aws.String(rs.Primary.ID),
+		}
+
+		resp, err := conn.DescribeArchive(&params)
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Events archive (%s) still exists: %s", rs.Primary.ID, resp)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudWatchEventArchiveExists(n string, v *events.DescribeArchiveOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		params := events.DescribeArchiveInput{
+			ArchiveName: aws.String(rs.Primary.ID),
+		}
+		resp, err := conn.DescribeArchive(&params)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("CloudWatch Events archive (%s) not found", n)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchEventArchiveConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+}
+`, name)
+}
+
+func testAccAWSCloudWatchEventArchiveConfig_updateAttributes(name, description string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+  description      = %[2]q
+  retention_days   = %[3]d
+
+  event_pattern = <<PATTERN
+{
+  "source": ["company.team.service"]
+}
+PATTERN
+}
+`, name, description, retentionDays)
+}
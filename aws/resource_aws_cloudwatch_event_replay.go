@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/waiter"
+)
+
+func resourceAwsCloudWatchEventReplay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventReplayCreate,
+		Read:   resourceAwsCloudWatchEventReplayRead,
+		Delete: resourceAwsCloudWatchEventReplayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"filter_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+			},
+			"event_end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUTCTimestamp,
+			},
+			"event_last_replayed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"event_start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUTCTimestamp,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+				),
+			},
+			"replay_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replay_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventReplayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	// Validated by the schema.
+	eventStartTime, _ := time.Parse(time.RFC3339, d.Get("event_start_time").(string))
+	eventEndTime, _ := time.Parse(time.RFC3339, d.Get("event_end_time").(string))
+
+	name := d.Get("name").(string)
+	input := &events.StartReplayInput{
+		Destination:    expandCloudWatchEventReplayDestination(d.Get("destination").([]interface{})),
+		EventEndTime:   aws.Time(eventEndTime),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+		EventStartTime: aws.Time(eventStartTime),
+		ReplayName:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting CloudWatch Events replay: %s", input)
+	_, err := conn.StartReplay(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting CloudWatch Events replay (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.ReplayCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events replay (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventReplayRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventReplayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.DescribeReplayInput{
+		ReplayName: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeReplay(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events replay (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events replay (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ReplayArn)
+	d.Set("description", output.Description)
+
+	if err := d.Set("destination", flattenCloudWatchEventReplayDestination(output.Destination)); err != nil {
+		return fmt.Errorf("error setting destination: %w", err)
+	}
+
+	d.Set("event_end_time", aws.TimeValue(output.EventEndTime).Format(time.RFC3339))
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set("event_start_time", aws.TimeValue(output.EventStartTime).Format(time.RFC3339))
+	d.Set("name", output.ReplayName)
+	d.Set("state", output.State)
+	d.Set("state_reason", output.StateReason)
+
+	if output.EventLastReplayedTime != nil {
+		d.Set("event_last_replayed_time", aws.TimeValue(output.EventLastReplayedTime).Format(time.RFC3339))
+	} else {
+		d.Set("event_last_replayed_time", nil)
+	}
+
+	if output.ReplayEndTime != nil {
+		d.Set("replay_end_time", aws.TimeValue(output.ReplayEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("replay_end_time", nil)
+	}
+
+	if output.ReplayStartTime != nil {
+		d.Set("replay_start_time", aws.TimeValue(output.ReplayStartTime).Format(time.RFC3339))
+	} else {
+		d.Set("replay_start_time", nil)
+	}
+
+	return nil
+}
+
+// Replays cannot be deleted. Destroying the resource cancels the replay if it
+// is still in progress and removes it from state.
+func resourceAwsCloudWatchEventReplayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	state := d.Get("state").(string)
+
+	if state != events.ReplayStateStarting && state != events.ReplayStateRunning {
+		return nil
+	}
+
+	log.Printf("[INFO] Cancelling CloudWatch Events replay (%s)", d.Id())
+	_, err := conn.CancelReplay(&events.CancelReplayInput{
+		ReplayName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) || tfawserr.ErrCodeEquals(err, events.ErrCodeIllegalStatusException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling CloudWatch Events replay (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ReplayCancelled(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events replay (%s) cancellation: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudWatchEventReplayDestination(tfList []interface{}) *events.ReplayDestination {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &events.ReplayDestination{
+		Arn: aws.String(tfMap["arn"].(string)),
+	}
+
+	if v, ok := tfMap["filter_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FilterArns = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudWatchEventReplayDestination(apiObject *events.ReplayDestination) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":         aws.StringValue(apiObject.Arn),
+		"filter_arns": aws.StringValueSlice(apiObject.FilterArns),
+	}
+
+	return []interface{}{tfMap}
+}
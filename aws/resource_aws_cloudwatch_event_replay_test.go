@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCloudWatchEventReplay_basic(t *testing.T) {
+	var v events.DescribeReplayOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_replay.test"
+
+	// The replay window must start after the archive is created and end in the past.
+	eventStartTime := time.Now().UTC().Add(1 * time.Minute).Truncate(time.Second)
+	eventEndTime := eventStartTime.Add(1 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventReplayConfigBase(rName),
+			},
+			{
+				PreConfig: func() {
+					time.Sleep(time.Until(eventEndTime.Add(10 * time.Second)))
+				},
+				Config: testAccAWSCloudWatchEventReplayConfig(rName, eventStartTime, eventEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventReplayExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("replay/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", "aws_cloudwatch_event_archive.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.arn", "aws_cloudwatch_event_bus.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "event_start_time", eventStartTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "event_end_time", eventEndTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "state", events.ReplayStateCompleted),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchEventReplayExists(n string, v *events.DescribeReplayOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		params := events.DescribeReplayInput{
+			ReplayName: aws.String(rs.Primary.ID),
+		}
+		resp, err := conn.DescribeReplay(&params)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("CloudWatch Events replay (%s) not found", n)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchEventReplayConfigBase(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+}
+`, name)
+}
+
+func testAccAWSCloudWatchEventReplayConfig(name string, eventStartTime, eventEndTime time.Time) string {
+	return composeConfig(
+		testAccAWSCloudWatchEventReplayConfigBase(name),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_event_replay" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_archive.test.arn
+  event_start_time = %[2]q
+  event_end_time   = %[3]q
+
+  destination {
+    arn = aws_cloudwatch_event_bus.test.arn
+  }
+}
+`, name, eventStartTime.Format(time.RFC3339), eventEndTime.Format(time.RFC3339)))
+}
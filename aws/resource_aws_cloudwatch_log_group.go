@@ -8,10 +8,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsCloudWatchLogGroup() *schema.Resource {
@@ -36,6 +36,7 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 			"name_prefix": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validateLogGroupNamePrefix,
 			},
@@ -66,14 +67,7 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().CloudwatchlogsTags()
 
-	var logGroupName string
-	if v, ok := d.GetOk("name"); ok {
-		logGroupName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		logGroupName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		logGroupName = resource.UniqueId()
-	}
+	logGroupName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	log.Printf("[DEBUG] Creating CloudWatch Log Group: %s", logGroupName)
 
@@ -135,6 +129,7 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("arn", strings.TrimSuffix(aws.StringValue(lg.Arn), ":*"))
 	d.Set("name", lg.LogGroupName)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(lg.LogGroupName)))
 	d.Set("kms_key_id", lg.KmsKeyId)
 	d.Set("retention_in_days", lg.RetentionInDays)
 
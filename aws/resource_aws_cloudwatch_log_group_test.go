@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func init() {
@@ -137,14 +138,15 @@ func TestAccAWSCloudWatchLogGroup_namePrefix(t *testing.T) {
 				Config: testAccAWSCloudWatchLogGroup_namePrefix,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudWatchLogGroupExists(resourceName, &lg),
-					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile("^tf-test-")),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-test-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-test-"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retention_in_days", "name_prefix"},
+				ImportStateVerifyIgnore: []string{"retention_in_days"},
 			},
 		},
 	})
@@ -199,6 +201,8 @@ func TestAccAWSCloudWatchLogGroup_generatedName(t *testing.T) {
 				Config: testAccAWSCloudWatchLogGroup_generatedName,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudWatchLogGroupExists(resourceName, &lg),
+					naming.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
 				),
 			},
 			{
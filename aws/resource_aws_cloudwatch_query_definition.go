@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchlogs/finder"
+)
+
+func resourceAwsCloudWatchQueryDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsCloudWatchQueryDefinitionPut,
+		ReadContext:   resourceAwsCloudWatchQueryDefinitionRead,
+		UpdateContext: resourceAwsCloudWatchQueryDefinitionPut,
+		DeleteContext: resourceAwsCloudWatchQueryDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_group_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateLogGroupName,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"query_definition_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchQueryDefinitionPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	name := d.Get("name").(string)
+
+	input := &cloudwatchlogs.PutQueryDefinitionInput{
+		Name:        aws.String(name),
+		QueryString: aws.String(d.Get("query_string").(string)),
+	}
+
+	if v, ok := d.GetOk("log_group_names"); ok && len(v.([]interface{})) > 0 {
+		input.LogGroupNames = expandStringList(v.([]interface{}))
+	}
+
+	if !d.IsNewResource() {
+		input.QueryDefinitionId = aws.String(d.Id())
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Logs Query Definition: %s", input)
+	output, err := conn.PutQueryDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting CloudWatch Logs Query Definition (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(aws.StringValue(output.QueryDefinitionId))
+	}
+
+	return resourceAwsCloudWatchQueryDefinitionRead(ctx, d, meta)
+}
+
+func resourceAwsCloudWatchQueryDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	queryDefinition, err := finder.QueryDefinition(ctx, conn, d.Get("name").(string), d.Id())
+
+	if err != nil {
+		return diag.Errorf("error reading CloudWatch Logs Query Definition (%s): %s", d.Id(), err)
+	}
+
+	if queryDefinition == nil {
+		if d.IsNewResource() {
+			return diag.Errorf("error reading CloudWatch Logs Query Definition (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudWatch Logs Query Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("log_group_names", aws.StringValueSlice(queryDefinition.LogGroupNames)); err != nil {
+		return diag.Errorf("error setting log_group_names: %s", err)
+	}
+
+	d.Set("name", queryDefinition.Name)
+	d.Set("query_definition_id", queryDefinition.QueryDefinitionId)
+	d.Set("query_string", queryDefinition.QueryString)
+
+	return nil
+}
+
+func resourceAwsCloudWatchQueryDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Query Definition: %s", d.Id())
+	_, err := conn.DeleteQueryDefinitionWithContext(ctx, &cloudwatchlogs.DeleteQueryDefinitionInput{
+		QueryDefinitionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting CloudWatch Logs Query Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchlogs/finder"
+)
+
+func TestAccAWSCloudWatchQueryDefinition_basic(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	queryName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic(queryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", queryName),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "fields @timestamp, @message\n| sort @timestamp desc\n| limit 20\n"),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "query_definition_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchQueryDefinition_disappears(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	queryName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic(queryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchQueryDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchQueryDefinition_folderName(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	queryName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic("runbooks/" + queryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "runbooks/"+queryName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic("runbooks/updated/" + queryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "runbooks/updated/"+queryName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchQueryDefinition_logGroupNames(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	queryName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_logGroupNames(queryName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_names.0", "aws_cloudwatch_log_group.test.0", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_logGroupNames(queryName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_names.0", "aws_cloudwatch_log_group.test.0", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_names.1", "aws_cloudwatch_log_group.test.1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Logs Query Definition ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+		queryDefinition, err := finder.QueryDefinition(context.Background(), conn, rs.Primary.Attributes["name"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if queryDefinition == nil {
+			return fmt.Errorf("CloudWatch Logs Query Definition (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchQueryDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_query_definition" {
+			continue
+		}
+
+		queryDefinition, err := finder.QueryDefinition(context.Background(), conn, rs.Primary.Attributes["name"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if queryDefinition != nil {
+			return fmt.Errorf("CloudWatch Logs Query Definition (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCloudWatchQueryDefinitionConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_query_definition" "test" {
+  name = %[1]q
+
+  query_string = <<EOF
+fields @timestamp, @message
+| sort @timestamp desc
+| limit 20
+EOF
+}
+`, name)
+}
+
+func testAccAWSCloudWatchQueryDefinitionConfig_logGroupNames(name string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_cloudwatch_query_definition" "test" {
+  name            = %[1]q
+  log_group_names = aws_cloudwatch_log_group.test[*].name
+
+  query_string = <<EOF
+fields @timestamp, @message
+| sort @timestamp desc
+| limit 20
+EOF
+}
+`, name, count)
+}
@@ -0,0 +1,511 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccConfigOrganizationConformancePack_basic(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "config", regexp.MustCompile(fmt.Sprintf("organization-conformance-pack/%s-.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", ""),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_key_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_disappears(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConfigOrganizationConformancePack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_errorHandling(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigOrganizationConformancePackErrorHandlingConfig(rName),
+				ExpectError: regexp.MustCompile(`NoAvailableConfigurationRecorder`),
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_ExcludedAccounts(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackExcludedAccounts1Config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+			{
+				Config: testAccConfigOrganizationConformancePackExcludedAccounts2Config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_InputParameters(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+	pKey := "ParamKey"
+	pValue := "ParamValue"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackInputParameterConfig(rName, pKey, pValue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "input_parameter.*", map[string]string{
+						"parameter_name":  pKey,
+						"parameter_value": pValue,
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_S3Delivery(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	bucketName := acctest.RandomWithPrefix("awsconfigconforms")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackS3DeliveryConfig(rName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_key_prefix", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_S3Template(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackS3TemplateConfig(rName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_s3_uri"},
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_updateTemplateBody(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+				),
+			},
+			{
+				Config: testAccConfigOrganizationConformancePackUpdateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccCheckConfigOrganizationConformancePackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_organization_conformance_pack" {
+			continue
+		}
+
+		pack, err := configDescribeOrganizationConformancePack(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConformancePackException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error describing Config Organization Conformance Pack (%s): %w", rs.Primary.ID, err)
+		}
+
+		if pack != nil {
+			return fmt.Errorf("Config Organization Conformance Pack (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckConfigOrganizationConformancePackExists(resourceName string, ocp *configservice.OrganizationConformancePack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		pack, err := configDescribeOrganizationConformancePack(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error describing Config Organization Conformance Pack (%s): %w", rs.Primary.ID, err)
+		}
+
+		if pack == nil {
+			return fmt.Errorf("Config Organization Conformance Pack (%s) not found", rs.Primary.ID)
+		}
+
+		*ocp = *pack
+
+		return nil
+	}
+}
+
+func testAccConfigOrganizationConformancePackConfigBase(rName string) string {
+	return composeConfig(
+		testAccConfigConformancePackConfigBase(rName),
+		`
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["config-multiaccountsetup.amazonaws.com"]
+  feature_set                   = "ALL"
+}
+`)
+}
+
+func testAccConfigOrganizationConformancePackBasicConfig(rName string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  name          = %[1]q
+  template_body = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
+func testAccConfigOrganizationConformancePackUpdateConfig(rName string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  name          = %[1]q
+  template_body = <<EOT
+Resources:
+  IAMGroupHasUsersCheck:
+    Properties:
+      ConfigRuleName: IAMGroupHasUsersCheck
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_GROUP_HAS_USERS_CHECK
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
+func testAccConfigOrganizationConformancePackErrorHandlingConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["config-multiaccountsetup.amazonaws.com"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  name          = %[1]q
+  template_body = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName)
+}
+
+func testAccConfigOrganizationConformancePackExcludedAccounts1Config(rName string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  excluded_accounts = ["111111111111"]
+  name              = %[1]q
+  template_body     = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
+func testAccConfigOrganizationConformancePackExcludedAccounts2Config(rName string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  excluded_accounts = ["111111111111", "222222222222"]
+  name              = %[1]q
+  template_body     = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
+func testAccConfigOrganizationConformancePackInputParameterConfig(rName, pName, pValue string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  input_parameter {
+    parameter_name  = %[2]q
+    parameter_value = %[3]q
+  }
+
+  name          = %[1]q
+  template_body = <<EOT
+Parameters:
+  %[2]s:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, pName, pValue))
+}
+
+func testAccConfigOrganizationConformancePackS3DeliveryConfig(rName, bucketName string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[2]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  delivery_s3_bucket     = aws_s3_bucket.test.id
+  delivery_s3_key_prefix = %[1]q
+  name                   = %[1]q
+  template_body          = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, bucketName))
+}
+
+func testAccConfigOrganizationConformancePackS3TemplateConfig(rName, bucketName string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[2]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = %[1]q
+  content = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  name            = %[1]q
+  template_s3_uri = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_bucket_object.test.id}"
+}
+`, rName, bucketName))
+}
@@ -45,6 +45,16 @@ func TestAccAWSConfig_serial(t *testing.T) {
 			"allParams":   testAccConfigDeliveryChannel_allParams,
 			"importBasic": testAccConfigDeliveryChannel_importBasic,
 		},
+		"OrganizationConformancePack": {
+			"basic":              testAccConfigOrganizationConformancePack_basic,
+			"disappears":         testAccConfigOrganizationConformancePack_disappears,
+			"errorHandling":      testAccConfigOrganizationConformancePack_errorHandling,
+			"ExcludedAccounts":   testAccConfigOrganizationConformancePack_ExcludedAccounts,
+			"InputParameters":    testAccConfigOrganizationConformancePack_InputParameters,
+			"S3Delivery":         testAccConfigOrganizationConformancePack_S3Delivery,
+			"S3Template":         testAccConfigOrganizationConformancePack_S3Template,
+			"updateTemplateBody": testAccConfigOrganizationConformancePack_updateTemplateBody,
+		},
 		"OrganizationCustomRule": {
 			"basic":                     testAccConfigOrganizationCustomRule_basic,
 			"disappears":                testAccConfigOrganizationCustomRule_disappears,
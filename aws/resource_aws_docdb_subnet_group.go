@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsDocDBSubnetGroup() *schema.Resource {
@@ -70,14 +71,7 @@ func resourceAwsDocDBSubnetGroupCreate(d *schema.ResourceData, meta interface{})
 
 	subnetIds := expandStringSet(d.Get("subnet_ids").(*schema.Set))
 
-	var groupName string
-	if v, ok := d.GetOk("name"); ok {
-		groupName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		groupName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		groupName = resource.UniqueId()
-	}
+	groupName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	createOpts := docdb.CreateDBSubnetGroupInput{
 		DBSubnetGroupName:        aws.String(groupName),
@@ -125,6 +119,7 @@ func resourceAwsDocDBSubnetGroupRead(d *schema.ResourceData, meta interface{}) e
 
 	subnetGroup := subnetGroups[0]
 	d.Set("name", subnetGroup.DBSubnetGroupName)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(subnetGroup.DBSubnetGroupName)))
 	d.Set("description", subnetGroup.DBSubnetGroupDescription)
 	d.Set("arn", subnetGroup.DBSubnetGroupArn)
 
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfdynamodb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsDynamoDbContributorInsights() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbContributorInsightsCreate,
+		Read:   resourceAwsDynamoDbContributorInsightsRead,
+		Delete: resourceAwsDynamoDbContributorInsightsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ContributorInsightsCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.ContributorInsightsDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbContributorInsightsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName := d.Get("table_name").(string)
+	indexName := d.Get("index_name").(string)
+	id := tfdynamodb.ContributorInsightsCreateResourceID(tableName, indexName)
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionEnable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Enabling DynamoDB Contributor Insights: %s", input)
+	_, err := conn.UpdateContributorInsights(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling DynamoDB Contributor Insights (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.ContributorInsightsEnabled(conn, tableName, indexName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be enabled: %w", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbContributorInsightsRead(d, meta)
+}
+
+func resourceAwsDynamoDbContributorInsightsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName, indexName, err := tfdynamodb.ContributorInsightsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.ContributorInsights(conn, tableName, indexName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(output.ContributorInsightsStatus); status == dynamodb.ContributorInsightsStatusDisabled || status == dynamodb.ContributorInsightsStatusDisabling {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Contributor Insights (%s): status %s after creation", d.Id(), status)
+		}
+
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) is %s, removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("index_name", output.IndexName)
+	d.Set("table_name", output.TableName)
+
+	return nil
+}
+
+func resourceAwsDynamoDbContributorInsightsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName, indexName, err := tfdynamodb.ContributorInsightsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionDisable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Disabling DynamoDB Contributor Insights: %s", d.Id())
+	_, err = conn.UpdateContributorInsights(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	_, err = waiter.ContributorInsightsDisabled(conn, tableName, indexName, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be disabled: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfdynamodb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSDynamoDbContributorInsights_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_contributor_insights.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbContributorInsightsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbContributorInsightsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_dynamodb_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "index_name", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbContributorInsights_IndexName(t *testing.T) {
+	resourceName := "aws_dynamodb_contributor_insights.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbContributorInsightsConfigIndexName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbContributorInsightsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_dynamodb_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "index_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbContributorInsights_disappears(t *testing.T) {
+	resourceName := "aws_dynamodb_contributor_insights.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbContributorInsightsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbContributorInsightsExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDynamoDbContributorInsights(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbContributorInsightsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Contributor Insights ID is set")
+		}
+
+		tableName, indexName, err := tfdynamodb.ContributorInsightsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		output, err := finder.ContributorInsights(conn, tableName, indexName)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.ContributorInsightsStatus); status != dynamodb.ContributorInsightsStatusEnabled {
+			return fmt.Errorf("DynamoDB Contributor Insights (%s) status is %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDynamoDbContributorInsightsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_contributor_insights" {
+			continue
+		}
+
+		tableName, indexName, err := tfdynamodb.ContributorInsightsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.ContributorInsights(conn, tableName, indexName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.ContributorInsightsStatus) == dynamodb.ContributorInsightsStatusDisabled {
+			continue
+		}
+
+		return fmt.Errorf("DynamoDB Contributor Insights (%s) still enabled", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSDynamoDbContributorInsightsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIRangeKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = %[1]q
+    hash_key        = "TestGSIRangeKey"
+    write_capacity  = 1
+    read_capacity   = 1
+    projection_type = "KEYS_ONLY"
+  }
+}
+`, rName)
+}
+
+func testAccAWSDynamoDbContributorInsightsConfigBasic(rName string) string {
+	return composeConfig(testAccAWSDynamoDbContributorInsightsConfigBase(rName), `
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+}
+`)
+}
+
+func testAccAWSDynamoDbContributorInsightsConfigIndexName(rName string) string {
+	return composeConfig(testAccAWSDynamoDbContributorInsightsConfigBase(rName), fmt.Sprintf(`
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+  index_name = %[1]q
+}
+`, rName))
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfdynamodb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsDynamoDbKinesisStreamingDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbKinesisStreamingDestinationCreate,
+		Read:   resourceAwsDynamoDbKinesisStreamingDestinationRead,
+		Delete: resourceAwsDynamoDbKinesisStreamingDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.KinesisStreamingDestinationActiveTimeout),
+			Delete: schema.DefaultTimeout(waiter.KinesisStreamingDestinationDisabledTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbKinesisStreamingDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	streamArn := d.Get("stream_arn").(string)
+	tableName := d.Get("table_name").(string)
+	id := tfdynamodb.KinesisStreamingDestinationCreateResourceID(tableName, streamArn)
+
+	input := &dynamodb.EnableKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamArn),
+		TableName: aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Enabling DynamoDB Kinesis streaming destination: %s", input)
+	_, err := conn.EnableKinesisStreamingDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling DynamoDB Kinesis streaming destination (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.KinesisStreamingDestinationActive(conn, tableName, streamArn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Kinesis streaming destination (%s) to be active: %w", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbKinesisStreamingDestinationRead(d, meta)
+}
+
+func resourceAwsDynamoDbKinesisStreamingDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName, streamArn, err := tfdynamodb.KinesisStreamingDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.KinesisDataStreamDestination(conn, tableName, streamArn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Kinesis streaming destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Kinesis streaming destination (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(output.DestinationStatus); status == dynamodb.DestinationStatusDisabled || status == dynamodb.DestinationStatusDisabling {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Kinesis streaming destination (%s): status %s after creation", d.Id(), status)
+		}
+
+		log.Printf("[WARN] DynamoDB Kinesis streaming destination (%s) is %s, removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("stream_arn", output.StreamArn)
+	d.Set("table_name", tableName)
+
+	return nil
+}
+
+func resourceAwsDynamoDbKinesisStreamingDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName, streamArn, err := tfdynamodb.KinesisStreamingDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.DisableKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamArn),
+		TableName: aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Disabling DynamoDB Kinesis streaming destination: %s", d.Id())
+	_, err = conn.DisableKinesisStreamingDestination(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling DynamoDB Kinesis streaming destination (%s): %w", d.Id(), err)
+	}
+
+	_, err = waiter.KinesisStreamingDestinationDisabled(conn, tableName, streamArn, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Kinesis streaming destination (%s) to be disabled: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfdynamodb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSDynamoDbKinesisStreamingDestination_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_kinesis_streaming_destination.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbKinesisStreamingDestinationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbKinesisStreamingDestinationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "stream_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_dynamodb_table.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbKinesisStreamingDestination_disappears(t *testing.T) {
+	resourceName := "aws_dynamodb_kinesis_streaming_destination.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbKinesisStreamingDestinationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbKinesisStreamingDestinationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDynamoDbKinesisStreamingDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbKinesisStreamingDestinationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Kinesis streaming destination ID is set")
+		}
+
+		tableName, streamArn, err := tfdynamodb.KinesisStreamingDestinationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		output, err := finder.KinesisDataStreamDestination(conn, tableName, streamArn)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.DestinationStatus); status != dynamodb.DestinationStatusActive {
+			return fmt.Errorf("DynamoDB Kinesis streaming destination (%s) status is %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_kinesis_streaming_destination" {
+			continue
+		}
+
+		tableName, streamArn, err := tfdynamodb.KinesisStreamingDestinationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.KinesisDataStreamDestination(conn, tableName, streamArn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.DestinationStatus) == dynamodb.DestinationStatusDisabled {
+			continue
+		}
+
+		return fmt.Errorf("DynamoDB Kinesis streaming destination (%s) still active", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSDynamoDbKinesisStreamingDestinationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_dynamodb_kinesis_streaming_destination" "test" {
+  stream_arn = aws_kinesis_stream.test.arn
+  table_name = aws_dynamodb_table.test.name
+}
+`, rName)
+}
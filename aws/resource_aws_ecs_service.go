@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/waiter"
 )
 
 func resourceAwsEcsService() *schema.Resource {
@@ -29,6 +30,8 @@ func resourceAwsEcsService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ServiceStableTimeout),
+			Update: schema.DefaultTimeout(waiter.ServiceStableTimeout),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -139,6 +142,25 @@ func resourceAwsEcsService() *schema.Resource {
 				Computed: true,
 			},
 
+			"deployment_circuit_breaker": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressEcsServiceDisabledDeploymentCircuitBreaker,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"rollback": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"deployment_controller": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -412,6 +434,14 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		input.DesiredCount = aws.Int64(int64(d.Get("desired_count").(int)))
 	}
 
+	if v, ok := d.GetOk("deployment_circuit_breaker"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		input.DeploymentConfiguration.DeploymentCircuitBreaker = expandEcsDeploymentCircuitBreaker(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("cluster"); ok {
 		input.Cluster = aws.String(v.(string))
 	}
@@ -474,25 +504,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	serviceRegistries := d.Get("service_registries").([]interface{})
 	if len(serviceRegistries) > 0 {
-		srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
-		for _, v := range serviceRegistries {
-			raw := v.(map[string]interface{})
-			sr := &ecs.ServiceRegistry{
-				RegistryArn: aws.String(raw["registry_arn"].(string)),
-			}
-			if port, ok := raw["port"].(int); ok && port != 0 {
-				sr.Port = aws.Int64(int64(port))
-			}
-			if raw, ok := raw["container_port"].(int); ok && raw != 0 {
-				sr.ContainerPort = aws.Int64(int64(raw))
-			}
-			if raw, ok := raw["container_name"].(string); ok && raw != "" {
-				sr.ContainerName = aws.String(raw)
-			}
-
-			srs = append(srs, sr)
-		}
-		input.ServiceRegistries = srs
+		input.ServiceRegistries = expandServiceRegistries(serviceRegistries)
 	}
 
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
@@ -535,7 +547,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(aws.StringValue(service.ServiceArn))
 
 	if d.Get("wait_for_steady_state").(bool) {
-		if err := waitForSteadyState(conn, d); err != nil {
+		if err := waitForSteadyState(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return err
 		}
 	}
@@ -660,6 +672,14 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	if service.DeploymentConfiguration != nil {
 		d.Set("deployment_maximum_percent", service.DeploymentConfiguration.MaximumPercent)
 		d.Set("deployment_minimum_healthy_percent", service.DeploymentConfiguration.MinimumHealthyPercent)
+
+		if v := service.DeploymentConfiguration.DeploymentCircuitBreaker; v != nil {
+			if err := d.Set("deployment_circuit_breaker", []interface{}{flattenEcsDeploymentCircuitBreaker(v)}); err != nil {
+				return fmt.Errorf("error setting deployment_circuit_breaker: %w", err)
+			}
+		} else {
+			d.Set("deployment_circuit_breaker", nil)
+		}
 	}
 
 	if err := d.Set("deployment_controller", flattenEcsDeploymentController(service.DeploymentController)); err != nil {
@@ -725,6 +745,52 @@ func flattenEcsDeploymentController(deploymentController *ecs.DeploymentControll
 	return []interface{}{m}
 }
 
+// suppressEcsServiceDisabledDeploymentCircuitBreaker suppresses the removal of a deployment_circuit_breaker
+// configuration block when the circuit breaker is disabled, as the two are equivalent.
+func suppressEcsServiceDisabledDeploymentCircuitBreaker(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("deployment_circuit_breaker")
+
+	if v, ok := n.([]interface{}); ok && len(v) > 0 {
+		return false
+	}
+
+	v, ok := o.([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return false
+	}
+
+	tfMap := v[0].(map[string]interface{})
+
+	return !tfMap["enable"].(bool) && !tfMap["rollback"].(bool)
+}
+
+func expandEcsDeploymentCircuitBreaker(tfMap map[string]interface{}) *ecs.DeploymentCircuitBreaker {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.DeploymentCircuitBreaker{
+		Enable:   aws.Bool(tfMap["enable"].(bool)),
+		Rollback: aws.Bool(tfMap["rollback"].(bool)),
+	}
+
+	return apiObject
+}
+
+func flattenEcsDeploymentCircuitBreaker(apiObject *ecs.DeploymentCircuitBreaker) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable":   aws.BoolValue(apiObject.Enable),
+		"rollback": aws.BoolValue(apiObject.Rollback),
+	}
+
+	return tfMap
+}
+
 func flattenEcsNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -914,6 +980,29 @@ func flattenPlacementStrategy(pss []*ecs.PlacementStrategy) []interface{} {
 	return results
 }
 
+func expandServiceRegistries(serviceRegistries []interface{}) []*ecs.ServiceRegistry {
+	srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
+	for _, v := range serviceRegistries {
+		raw := v.(map[string]interface{})
+		sr := &ecs.ServiceRegistry{
+			RegistryArn: aws.String(raw["registry_arn"].(string)),
+		}
+		if port, ok := raw["port"].(int); ok && port != 0 {
+			sr.Port = aws.Int64(int64(port))
+		}
+		if raw, ok := raw["container_port"].(int); ok && raw != 0 {
+			sr.ContainerPort = aws.Int64(int64(raw))
+		}
+		if raw, ok := raw["container_name"].(string); ok && raw != "" {
+			sr.ContainerName = aws.String(raw)
+		}
+
+		srs = append(srs, sr)
+	}
+
+	return srs
+}
+
 func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface{} {
 	if len(srs) == 0 {
 		return nil
@@ -950,7 +1039,7 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	schedulingStrategy := d.Get("scheduling_strategy").(string)
 
 	if schedulingStrategy == ecs.SchedulingStrategyDaemon {
-		if d.HasChange("deployment_minimum_healthy_percent") {
+		if d.HasChanges("deployment_circuit_breaker", "deployment_minimum_healthy_percent") {
 			updateService = true
 			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{
 				MinimumHealthyPercent: aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
@@ -962,7 +1051,7 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 			input.DesiredCount = aws.Int64(int64(d.Get("desired_count").(int)))
 		}
 
-		if d.HasChanges("deployment_maximum_percent", "deployment_minimum_healthy_percent") {
+		if d.HasChanges("deployment_circuit_breaker", "deployment_maximum_percent", "deployment_minimum_healthy_percent") {
 			updateService = true
 			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{
 				MaximumPercent:        aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
@@ -971,6 +1060,18 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if input.DeploymentConfiguration != nil {
+		// Explicitly disable the circuit breaker when its configuration block is removed.
+		input.DeploymentConfiguration.DeploymentCircuitBreaker = &ecs.DeploymentCircuitBreaker{
+			Enable:   aws.Bool(false),
+			Rollback: aws.Bool(false),
+		}
+
+		if v, ok := d.GetOk("deployment_circuit_breaker"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DeploymentConfiguration.DeploymentCircuitBreaker = expandEcsDeploymentCircuitBreaker(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("ordered_placement_strategy") {
 		updateService = true
 		// Reference: https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_UpdateService.html#ECS-UpdateService-request-placementStrategy
@@ -1055,7 +1156,7 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if d.Get("wait_for_steady_state").(bool) {
-		if err := waitForSteadyState(conn, d); err != nil {
+		if err := waitForSteadyState(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
 		}
 	}
@@ -1190,15 +1291,8 @@ func getNameFromARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
 
-func waitForSteadyState(conn *ecs.ECS, d *schema.ResourceData) error {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{d.Id()}),
-	}
-	if v, ok := d.GetOk("cluster"); ok {
-		input.Cluster = aws.String(v.(string))
-	}
-
-	if err := conn.WaitUntilServicesStable(input); err != nil {
+func waitForSteadyState(conn *ecs.ECS, d *schema.ResourceData, timeout time.Duration) error {
+	if _, err := waiter.ServiceStable(conn, d.Id(), d.Get("cluster").(string), timeout); err != nil {
 		return fmt.Errorf("error waiting for service (%s) to reach a steady state: %w", d.Id(), err)
 	}
 	return nil
@@ -519,6 +519,78 @@ func TestAccAWSEcsService_withDeploymentValues(t *testing.T) {
 	})
 }
 
+func TestAccAWSEcsService_withDeploymentCircuitBreaker(t *testing.T) {
+	var service ecs.Service
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsServiceConfigDeploymentCircuitBreaker(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.0.rollback", "true"),
+				),
+			},
+			{
+				Config: testAccAWSEcsServiceConfigDeploymentCircuitBreaker(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.0.rollback", "false"),
+				),
+			},
+			{
+				Config: testAccAWSEcsServiceConfigDeploymentCircuitBreaker(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.0.enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.0.rollback", "false"),
+				),
+			},
+			{
+				Config: testAccAWSEcsServiceConfigDeploymentPercents(rName, 0, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists(resourceName, &service),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsService_withDeploymentCircuitBreaker_failedDeployment(t *testing.T) {
+	var service ecs.Service
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsServiceConfigDeploymentCircuitBreakerFailedDeployment(rName, "mongo:latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists(resourceName, &service),
+				),
+			},
+			{
+				// Replacing the task definition starts a new deployment whose tasks cannot pull their image.
+				Config:      testAccAWSEcsServiceConfigDeploymentCircuitBreakerFailedDeployment(rName, "tf-acc-test-does-not-exist/mongo:latest"),
+				ExpectError: regexp.MustCompile(`deployment \(.+\) failed`),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform-provider-aws/issues/6315
 func TestAccAWSEcsService_withDeploymentMinimumZeroMaximumOneHundred(t *testing.T) {
 	var service ecs.Service
@@ -3606,6 +3678,157 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccAWSEcsServiceConfigDeploymentCircuitBreaker(rName string, enable, rollback bool) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  cluster                            = aws_ecs_cluster.test.id
+  deployment_maximum_percent         = 100
+  deployment_minimum_healthy_percent = 0
+  desired_count                      = 1
+  name                               = %[1]q
+  task_definition                    = aws_ecs_task_definition.test.arn
+
+  deployment_circuit_breaker {
+    enable   = %[2]t
+    rollback = %[3]t
+  }
+}
+`, rName, enable, rollback)
+}
+
+// testAccAWSEcsServiceConfigDeploymentCircuitBreakerFailedDeployment returns a configuration for a
+// Fargate service with the deployment circuit breaker enabled, running the specified container image.
+// The update timeout allows for the failed task launches needed to trip the circuit breaker.
+func testAccAWSEcsServiceConfigDeploymentCircuitBreakerFailedDeployment(rName, image string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 1)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = aws_route_table.test.id
+  subnet_id      = aws_subnet.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    cidr_blocks = ["0.0.0.0/0"]
+    from_port   = 0
+    protocol    = "-1"
+    to_port     = 0
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "essential": true,
+    "image": %[2]q,
+    "name": "test"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  cluster               = aws_ecs_cluster.test.id
+  desired_count         = 1
+  launch_type           = "FARGATE"
+  name                  = %[1]q
+  task_definition       = aws_ecs_task_definition.test.arn
+  wait_for_steady_state = true
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = false
+  }
+
+  network_configuration {
+    assign_public_ip = true
+    security_groups  = [aws_security_group.test.id]
+    subnets          = [aws_subnet.test.id]
+  }
+
+  timeouts {
+    update = "45m"
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, rName, image))
+}
+
 func testAccAWSEcsServiceConfigDeploymentPercents(rName string, deploymentMinimumHealthyPercent, deploymentMaximumPercent int) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
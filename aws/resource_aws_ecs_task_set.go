@@ -0,0 +1,495 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfecs "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEcsTaskSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcsTaskSetCreate,
+		Read:   resourceAwsEcsTaskSetRead,
+		Update: resourceAwsEcsTaskSetUpdate,
+		Delete: resourceAwsEcsTaskSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.TaskSetStableTimeout),
+			Update: schema.DefaultTimeout(waiter.TaskSetStableTimeout),
+			Delete: schema.DefaultTimeout(waiter.TaskSetDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_provider_strategy": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"launch_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"capacity_provider_strategy"},
+				ValidateFunc:  validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"load_balancer": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65536),
+						},
+						"elb_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"target_group_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+				Set: resourceAwsEcsLoadBalancerHash,
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scale": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ecs.ScaleUnitPercent,
+							ValidateFunc: validation.StringInSlice(ecs.ScaleUnit_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0.0, 100.0),
+						},
+					},
+				},
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_registries": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65536),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65536),
+						},
+						"registry_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"stability_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"task_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_until_stable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsEcsTaskSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	cluster := d.Get("cluster").(string)
+	service := d.Get("service").(string)
+
+	input := &ecs.CreateTaskSetInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		Cluster:        aws.String(cluster),
+		Service:        aws.String(service),
+		TaskDefinition: aws.String(d.Get("task_definition").(string)),
+	}
+
+	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
+		input.CapacityProviderStrategy = expandEcsCapacityProviderStrategy(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("external_id"); ok {
+		input.ExternalId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("load_balancer"); ok && v.(*schema.Set).Len() > 0 {
+		input.LoadBalancers = expandEcsLoadBalancers(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok {
+		input.NetworkConfiguration = expandEcsNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		input.PlatformVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scale"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Scale = expandEcsScale(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_registries"); ok && len(v.([]interface{})) > 0 {
+		input.ServiceRegistries = expandServiceRegistries(v.([]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().EcsTags()
+	}
+
+	log.Printf("[DEBUG] Creating ECS Task Set: %s", input)
+	output, err := conn.CreateTaskSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ECS Task Set (service: %s, cluster: %s): %w", service, cluster, err)
+	}
+
+	taskSetID := aws.StringValue(output.TaskSet.Id)
+
+	d.SetId(tfecs.TaskSetCreateResourceID(taskSetID, service, cluster))
+
+	if d.Get("wait_until_stable").(bool) {
+		if _, err := waiter.TaskSetStable(conn, taskSetID, service, cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for ECS Task Set (%s) to be stable: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEcsTaskSetRead(d, meta)
+}
+
+func resourceAwsEcsTaskSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	taskSetID, service, cluster, err := tfecs.TaskSetParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	taskSet, err := finder.TaskSetByID(conn, taskSetID, service, cluster)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Task Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ECS Task Set (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", taskSet.TaskSetArn)
+
+	if err := d.Set("capacity_provider_strategy", flattenEcsCapacityProviderStrategy(taskSet.CapacityProviderStrategy)); err != nil {
+		return fmt.Errorf("error setting capacity_provider_strategy: %w", err)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("external_id", taskSet.ExternalId)
+	d.Set("launch_type", taskSet.LaunchType)
+
+	if err := d.Set("load_balancer", flattenEcsLoadBalancers(taskSet.LoadBalancers)); err != nil {
+		return fmt.Errorf("error setting load_balancer: %w", err)
+	}
+
+	if err := d.Set("network_configuration", flattenEcsNetworkConfiguration(taskSet.NetworkConfiguration)); err != nil {
+		return fmt.Errorf("error setting network_configuration: %w", err)
+	}
+
+	d.Set("platform_version", taskSet.PlatformVersion)
+
+	if taskSet.Scale != nil {
+		if err := d.Set("scale", []interface{}{flattenEcsScale(taskSet.Scale)}); err != nil {
+			return fmt.Errorf("error setting scale: %w", err)
+		}
+	} else {
+		d.Set("scale", nil)
+	}
+
+	d.Set("service", service)
+
+	if err := d.Set("service_registries", flattenServiceRegistries(taskSet.ServiceRegistries)); err != nil {
+		return fmt.Errorf("error setting service_registries: %w", err)
+	}
+
+	d.Set("stability_status", taskSet.StabilityStatus)
+	d.Set("status", taskSet.Status)
+	d.Set("task_definition", taskSet.TaskDefinition)
+	d.Set("task_set_id", taskSet.Id)
+
+	if err := d.Set("tags", keyvaluetags.EcsKeyValueTags(taskSet.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEcsTaskSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	taskSetID, service, cluster, err := tfecs.TaskSetParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("scale") {
+		input := &ecs.UpdateTaskSetInput{
+			Cluster: aws.String(cluster),
+			Service: aws.String(service),
+			TaskSet: aws.String(taskSetID),
+		}
+
+		if v, ok := d.GetOk("scale"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Scale = expandEcsScale(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating ECS Task Set: %s", input)
+		_, err := conn.UpdateTaskSet(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating ECS Task Set (%s): %w", d.Id(), err)
+		}
+
+		if d.Get("wait_until_stable").(bool) {
+			if _, err := waiter.TaskSetStable(conn, taskSetID, service, cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for ECS Task Set (%s) to be stable: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.EcsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating ECS Task Set (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEcsTaskSetRead(d, meta)
+}
+
+func resourceAwsEcsTaskSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	taskSetID, service, cluster, err := tfecs.TaskSetParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &ecs.DeleteTaskSetInput{
+		Cluster: aws.String(cluster),
+		Force:   aws.Bool(d.Get("force_delete").(bool)),
+		Service: aws.String(service),
+		TaskSet: aws.String(taskSetID),
+	}
+
+	log.Printf("[DEBUG] Deleting ECS Task Set: %s", d.Id())
+	_, err = conn.DeleteTaskSet(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) ||
+		tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) ||
+		tfawserr.ErrCodeEquals(err, ecs.ErrCodeTaskSetNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECS Task Set (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TaskSetDeleted(conn, taskSetID, service, cluster, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for ECS Task Set (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEcsScale(tfMap map[string]interface{}) *ecs.Scale {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.Scale{}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(float64); ok {
+		apiObject.Value = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func flattenEcsScale(apiObject *ecs.Scale) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unit":  aws.StringValue(apiObject.Unit),
+		"value": aws.Float64Value(apiObject.Value),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfeks "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/waiter"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEksAddon() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEksAddonCreate,
+		Read:   resourceAwsEksAddonRead,
+		Update: resourceAwsEksAddonUpdate,
+		Delete: resourceAwsEksAddonDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.AddonCreatedTimeout),
+			Update: schema.DefaultTimeout(waiter.AddonUpdatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.AddonDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"addon_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resolve_conflicts": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+			},
+			"service_account_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEksAddonCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+	addonName := d.Get("addon_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+	id := tfeks.AddonCreateResourceID(clusterName, addonName)
+
+	input := &eks.CreateAddonInput{
+		AddonName:          aws.String(addonName),
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+	}
+
+	if v, ok := d.GetOk("addon_version"); ok {
+		input.AddonVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resolve_conflicts"); ok {
+		input.ResolveConflicts = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("service_account_role_arn"); ok {
+		input.ServiceAccountRoleArn = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().EksTags()
+	}
+
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.CreateAddon(input)
+
+		// Retry for IAM eventual consistency on error:
+		// InvalidParameterException: IAM role ... is not valid
+		if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "IAM role") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.CreateAddon(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating EKS Add-On (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.AddonCreated(conn, clusterName, addonName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EKS Add-On (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsEksAddonRead(d, meta)
+}
+
+func resourceAwsEksAddonRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	clusterName, addonName, err := tfeks.AddonParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	addon, err := finder.AddonByClusterNameAndAddonName(conn, clusterName, addonName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Add-On (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EKS Add-On (%s): %w", d.Id(), err)
+	}
+
+	d.Set("addon_name", addon.AddonName)
+	d.Set("addon_version", addon.AddonVersion)
+	d.Set("arn", addon.AddonArn)
+	d.Set("cluster_name", addon.ClusterName)
+	d.Set("created_at", aws.TimeValue(addon.CreatedAt).Format(time.RFC3339))
+	d.Set("modified_at", aws.TimeValue(addon.ModifiedAt).Format(time.RFC3339))
+	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
+	d.Set("status", addon.Status)
+
+	if err := d.Set("tags", keyvaluetags.EksKeyValueTags(addon.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEksAddonUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	clusterName, addonName, err := tfeks.AddonParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("addon_version", "service_account_role_arn") {
+		input := &eks.UpdateAddonInput{
+			AddonName:          aws.String(addonName),
+			ClientRequestToken: aws.String(resource.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+		}
+
+		if d.HasChange("addon_version") {
+			input.AddonVersion = aws.String(d.Get("addon_version").(string))
+		}
+
+		if v, ok := d.GetOk("resolve_conflicts"); ok {
+			input.ResolveConflicts = aws.String(v.(string))
+		}
+
+		// If service account role ARN is already provided, use it. Otherwise, the add-on uses
+		// permissions assigned to the node IAM role.
+		// https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html#API_UpdateAddon_RequestSyntax
+		input.ServiceAccountRoleArn = aws.String(d.Get("service_account_role_arn").(string))
+
+		output, err := conn.UpdateAddon(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EKS Add-On (%s): %w", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		if _, err := waiter.AddonUpdateSuccessful(conn, clusterName, addonName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EKS Add-On (%s) update (%s): %w", d.Id(), updateID, err)
+		}
+
+		if _, err := waiter.AddonActive(conn, clusterName, addonName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EKS Add-On (%s) to become active: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := keyvaluetags.EksUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %w", err)
+		}
+	}
+
+	return resourceAwsEksAddonRead(d, meta)
+}
+
+func resourceAwsEksAddonDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	clusterName, addonName, err := tfeks.AddonParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Add-On: %s", d.Id())
+	_, err = conn.DeleteAddon(&eks.DeleteAddonInput{
+		AddonName:   aws.String(addonName),
+		ClusterName: aws.String(clusterName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EKS Add-On (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.AddonDeleted(conn, clusterName, addonName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EKS Add-On (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfeks "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_eks_addon", &resource.Sweeper{
+		Name: "aws_eks_addon",
+		F:    testSweepEksAddons,
+	})
+}
+
+func testSweepEksAddons(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).eksconn
+
+	var errors error
+	input := &eks.ListClustersInput{}
+	err = conn.ListClustersPages(input, func(page *eks.ListClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.Clusters {
+			clusterName := aws.StringValue(cluster)
+			input := &eks.ListAddonsInput{
+				ClusterName: cluster,
+			}
+			err := conn.ListAddonsPages(input, func(page *eks.ListAddonsOutput, lastPage bool) bool {
+				for _, addon := range page.Addons {
+					addonName := aws.StringValue(addon)
+					log.Printf("[INFO] Deleting EKS Add-On %s from cluster %s", addonName, clusterName)
+					_, err := conn.DeleteAddon(&eks.DeleteAddonInput{
+						AddonName:   addon,
+						ClusterName: cluster,
+					})
+
+					if err != nil && !isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+						errors = multierror.Append(errors, fmt.Errorf("error deleting EKS Add-On %s: %w", addonName, err))
+						continue
+					}
+
+					if _, err := waiter.AddonDeleted(conn, clusterName, addonName, waiter.AddonDeletedTimeout); err != nil {
+						errors = multierror.Append(errors, fmt.Errorf("error waiting for EKS Add-On %s deletion: %w", addonName, err))
+						continue
+					}
+				}
+				return true
+			})
+			if err != nil {
+				errors = multierror.Append(errors, fmt.Errorf("error listing EKS Add-Ons for cluster %s: %w", clusterName, err))
+			}
+		}
+
+		return true
+	})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EKS Add-Ons sweep for %s: %s", region, err)
+		return errors // In case we have completed some pages, but had errors
+	}
+	if err != nil {
+		errors = multierror.Append(errors, fmt.Errorf("error retrieving EKS Clusters: %w", err))
+	}
+
+	return errors
+}
+
+func TestAccAWSEksAddon_basic(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	clusterResourceName := "aws_eks_cluster.test"
+	addonResourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigBasic(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(addonResourceName, &addon),
+					testAccMatchResourceAttrRegionalARN(addonResourceName, "arn", "eks", regexp.MustCompile(fmt.Sprintf("addon/%s/%s/.+$", rName, addonName))),
+					resource.TestCheckResourceAttrPair(addonResourceName, "cluster_name", clusterResourceName, "name"),
+					resource.TestCheckResourceAttr(addonResourceName, "addon_name", addonName),
+					resource.TestCheckResourceAttrSet(addonResourceName, "addon_version"),
+					resource.TestCheckResourceAttr(addonResourceName, "status", eks.AddonStatusActive),
+					resource.TestCheckResourceAttr(addonResourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      addonResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_disappears(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigBasic(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEksAddon(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_AddonVersion(t *testing.T) {
+	var addon1, addon2 eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+	addonVersion1 := "v1.6.3-eksbuild.1"
+	addonVersion2 := "v1.7.5-eksbuild.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigAddonVersion(rName, addonName, addonVersion1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon1),
+					resource.TestCheckResourceAttr(resourceName, "addon_version", addonVersion1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolve_conflicts"},
+			},
+			{
+				Config: testAccAWSEksAddonConfigAddonVersion(rName, addonName, addonVersion2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon2),
+					resource.TestCheckResourceAttr(resourceName, "addon_version", addonVersion2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_ServiceAccountRoleArn(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	serviceRoleResourceName := "aws_iam_role.test-service-role"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigServiceAccountRoleArn(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					resource.TestCheckResourceAttrPair(resourceName, "service_account_role_arn", serviceRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_Tags(t *testing.T) {
+	var addon1, addon2, addon3 eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigTags1(rName, addonName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEksAddonConfigTags2(rName, addonName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEksAddonConfigTags1(rName, addonName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEksAddonExists(resourceName string, addon *eks.Addon) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Add-On ID is set")
+		}
+
+		clusterName, addonName, err := tfeks.AddonParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).eksconn
+
+		output, err := finder.AddonByClusterNameAndAddonName(conn, clusterName, addonName)
+
+		if err != nil {
+			return err
+		}
+
+		*addon = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEksAddonDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).eksconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eks_addon" {
+			continue
+		}
+
+		clusterName, addonName, err := tfeks.AddonParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.AddonByClusterNameAndAddonName(conn, clusterName, addonName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EKS Add-On %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEksAddonConfigBase(rName string) string {
+	return testAccAWSEksClusterConfig_Required(rName)
+}
+
+func testAccAWSEksAddonConfigBasic(rName, addonName string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[1]q
+}
+`, addonName))
+}
+
+func testAccAWSEksAddonConfigAddonVersion(rName, addonName, addonVersion string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name      = aws_eks_cluster.test.name
+  addon_name        = %[1]q
+  addon_version     = %[2]q
+  resolve_conflicts = "OVERWRITE"
+}
+`, addonName, addonVersion))
+}
+
+func testAccAWSEksAddonConfigServiceAccountRoleArn(rName, addonName string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test-service-role" {
+  name               = "%[2]s-service"
+  assume_role_policy = data.aws_iam_policy_document.test-service-role.json
+}
+
+data "aws_iam_policy_document" "test-service-role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["eks.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name             = aws_eks_cluster.test.name
+  addon_name               = %[1]q
+  service_account_role_arn = aws_iam_role.test-service-role.arn
+}
+`, addonName, rName))
+}
+
+func testAccAWSEksAddonConfigTags1(rName, addonName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, addonName, tagKey1, tagValue1))
+}
+
+func testAccAWSEksAddonConfigTags2(rName, addonName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, addonName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
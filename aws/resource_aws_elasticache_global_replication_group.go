@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	elasticacheGlobalReplicationGroupMemberRolePrimary   = "PRIMARY"
+	elasticacheGlobalReplicationGroupMemberRoleSecondary = "SECONDARY"
+)
+
+func resourceAwsElasticacheGlobalReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheGlobalReplicationGroupCreate,
+		Read:   resourceAwsElasticacheGlobalReplicationGroupRead,
+		Update: resourceAwsElasticacheGlobalReplicationGroupUpdate,
+		Delete: resourceAwsElasticacheGlobalReplicationGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.GlobalReplicationGroupDefaultCreatedTimeout),
+			Update: schema.DefaultTimeout(waiter.GlobalReplicationGroupDefaultUpdatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.GlobalReplicationGroupDefaultDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"at_rest_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auth_token_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version_actual": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_replication_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_replication_group_id_suffix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_replication_group_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"primary_replication_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"transit_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.CreateGlobalReplicationGroupInput{
+		GlobalReplicationGroupIdSuffix: aws.String(d.Get("global_replication_group_id_suffix").(string)),
+		PrimaryReplicationGroupId:      aws.String(d.Get("primary_replication_group_id").(string)),
+	}
+
+	if v, ok := d.GetOk("global_replication_group_description"); ok {
+		input.GlobalReplicationGroupDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateGlobalReplicationGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: %w", err)
+	}
+
+	if output == nil || output.GlobalReplicationGroup == nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: empty result")
+	}
+
+	d.SetId(aws.StringValue(output.GlobalReplicationGroup.GlobalReplicationGroupId))
+
+	if _, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(globalReplicationGroup.Status); !d.IsNewResource() && (status == waiter.GlobalReplicationGroupStatusDeleting || status == waiter.GlobalReplicationGroupStatusDeleted) {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) in deleted state (%s), removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalReplicationGroup.ARN)
+	d.Set("at_rest_encryption_enabled", globalReplicationGroup.AtRestEncryptionEnabled)
+	d.Set("auth_token_enabled", globalReplicationGroup.AuthTokenEnabled)
+	d.Set("cache_node_type", globalReplicationGroup.CacheNodeType)
+	d.Set("cluster_enabled", globalReplicationGroup.ClusterEnabled)
+	d.Set("engine", globalReplicationGroup.Engine)
+	d.Set("engine_version_actual", globalReplicationGroup.EngineVersion)
+	d.Set("global_replication_group_description", globalReplicationGroup.GlobalReplicationGroupDescription)
+	d.Set("global_replication_group_id", globalReplicationGroup.GlobalReplicationGroupId)
+	d.Set("transit_encryption_enabled", globalReplicationGroup.TransitEncryptionEnabled)
+
+	if member := elasticacheGlobalReplicationGroupPrimaryMember(globalReplicationGroup); member != nil {
+		d.Set("primary_replication_group_id", member.ReplicationGroupId)
+	}
+
+	return nil
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	if d.HasChange("global_replication_group_description") {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:                  aws.Bool(true),
+			GlobalReplicationGroupDescription: aws.String(d.Get("global_replication_group_description").(string)),
+			GlobalReplicationGroupId:          aws.String(d.Id()),
+		}
+
+		if _, err := conn.ModifyGlobalReplicationGroup(input); err != nil {
+			return fmt.Errorf("error updating ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("primary_replication_group_id") {
+		if err := elasticacheGlobalReplicationGroupFailover(conn, d.Id(), d.Get("primary_replication_group_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error failing over ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, d.Id())
+	if tfresource.NotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	// Secondary members must be disassociated before the Global Replication Group can be deleted.
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.Role) != elasticacheGlobalReplicationGroupMemberRoleSecondary {
+			continue
+		}
+
+		err := disassociateElasticacheReplicationGroup(conn, d.Id(), aws.StringValue(member.ReplicationGroupId), aws.StringValue(member.ReplicationGroupRegion))
+		if err != nil {
+			return fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	if err := deleteElasticacheGlobalReplicationGroup(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func deleteElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id string, timeout time.Duration) error {
+	input := &elasticache.DeleteGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:      aws.String(id),
+		RetainPrimaryReplicationGroup: aws.Bool(true),
+	}
+
+	// Using Update timeout because the Global Replication Group could be in the middle of an update operation
+	err := resource.Retry(waiter.GlobalReplicationGroupDefaultUpdatedTimeout, func() *resource.RetryError {
+		_, err := conn.DeleteGlobalReplicationGroup(input)
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			return nil
+		}
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if tfresource.TimedOut(err) {
+		_, err = conn.DeleteGlobalReplicationGroup(input)
+	}
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := waiter.GlobalReplicationGroupDeleted(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for deletion: %w", err)
+	}
+
+	return nil
+}
+
+// disassociateElasticacheReplicationGroup removes a Replication Group from a Global Replication Group
+// and waits for the member to be detached.
+func disassociateElasticacheReplicationGroup(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID, region string) error {
+	input := &elasticache.DisassociateGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: aws.String(globalReplicationGroupID),
+		ReplicationGroupId:       aws.String(replicationGroupID),
+		ReplicationGroupRegion:   aws.String(region),
+	}
+
+	if _, err := waiter.GlobalReplicationGroupDisassociationReady(conn, globalReplicationGroupID); err != nil {
+		return fmt.Errorf("error waiting for Global Replication Group (%s) to be available before disassociating Replication Group (%s): %w", globalReplicationGroupID, replicationGroupID, err)
+	}
+
+	_, err := conn.DisassociateGlobalReplicationGroup(input)
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+	if tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidParameterValueException, "is not associated with Global Replication Group") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disassociating Replication Group (%s) from Global Replication Group (%s): %w", replicationGroupID, globalReplicationGroupID, err)
+	}
+
+	if _, err := waiter.GlobalReplicationGroupMemberDetached(conn, globalReplicationGroupID, replicationGroupID); err != nil {
+		return fmt.Errorf("error waiting for Replication Group (%s) to be disassociated from Global Replication Group (%s): %w", replicationGroupID, globalReplicationGroupID, err)
+	}
+
+	return nil
+}
+
+// elasticacheGlobalReplicationGroupFailover promotes a secondary member Replication Group to be the primary.
+func elasticacheGlobalReplicationGroupFailover(conn *elasticache.ElastiCache, globalReplicationGroupID, primaryReplicationGroupID string, timeout time.Duration) error {
+	globalReplicationGroup, err := waiter.GlobalReplicationGroupAvailable(conn, globalReplicationGroupID, timeout)
+	if err != nil {
+		return fmt.Errorf("error waiting for Global Replication Group to be available: %w", err)
+	}
+
+	var primaryRegion string
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.ReplicationGroupId) == primaryReplicationGroupID {
+			primaryRegion = aws.StringValue(member.ReplicationGroupRegion)
+			break
+		}
+	}
+
+	if primaryRegion == "" {
+		return fmt.Errorf("Replication Group (%s) is not a member of the Global Replication Group", primaryReplicationGroupID)
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(globalReplicationGroupID),
+		PrimaryRegion:             aws.String(primaryRegion),
+		PrimaryReplicationGroupId: aws.String(primaryReplicationGroupID),
+	}
+
+	log.Printf("[DEBUG] Failing over ElastiCache Global Replication Group: %s", input)
+	if _, err := conn.FailoverGlobalReplicationGroup(input); err != nil {
+		return err
+	}
+
+	if _, err := waiter.GlobalReplicationGroupAvailable(conn, globalReplicationGroupID, timeout); err != nil {
+		return fmt.Errorf("error waiting for failover: %w", err)
+	}
+
+	return nil
+}
+
+func elasticacheGlobalReplicationGroupPrimaryMember(globalReplicationGroup *elasticache.GlobalReplicationGroup) *elasticache.GlobalReplicationGroupMember {
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.Role) == elasticacheGlobalReplicationGroupMemberRolePrimary {
+			return member
+		}
+	}
+
+	return nil
+}
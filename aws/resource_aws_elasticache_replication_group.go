@@ -45,17 +45,19 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Computed: true,
 			},
 			"at_rest_encryption_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-				ForceNew: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"global_replication_group_id"},
 			},
 			"auth_token": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Sensitive:    true,
-				ForceNew:     true,
-				ValidateFunc: validateAwsElastiCacheReplicationGroupAuthToken,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ValidateFunc:  validateAwsElastiCacheReplicationGroupAuthToken,
+				ConflictsWith: []string{"global_replication_group_id"},
 			},
 			"auto_minor_version_upgrade": {
 				Type:     schema.TypeBool,
@@ -102,16 +104,29 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Computed: true,
 			},
 			"engine": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Default:      "redis",
-				ValidateFunc: validation.StringInSlice([]string{"redis"}, true),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       "redis",
+				ValidateFunc:  validation.StringInSlice([]string{"redis"}, true),
+				ConflictsWith: []string{"global_replication_group_id"},
 			},
 			"engine_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"global_replication_group_id"},
+			},
+			"global_replication_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ForceNew: true,
 				Computed: true,
+				ConflictsWith: []string{
+					"cluster_mode",
+					"snapshot_arns",
+					"snapshot_name",
+				},
 			},
 			"maintenance_window": {
 				Type:     schema.TypeString,
@@ -135,9 +150,10 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Default:  false,
 			},
 			"node_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"global_replication_group_id"},
 			},
 			"notification_topic_arn": {
 				Type:         schema.TypeString,
@@ -151,9 +167,10 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				ExactlyOneOf: []string{"cluster_mode", "number_cache_clusters"},
 			},
 			"parameter_group_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"global_replication_group_id"},
 			},
 			"port": {
 				Type:     schema.TypeInt,
@@ -247,10 +264,11 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 			},
 			"tags": tagsSchema(),
 			"transit_encryption_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-				ForceNew: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"global_replication_group_id"},
 			},
 			"kms_key_id": {
 				Type:     schema.TypeString,
@@ -326,11 +344,17 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		ReplicationGroupDescription: aws.String(d.Get("replication_group_description").(string)),
 		AutomaticFailoverEnabled:    aws.Bool(d.Get("automatic_failover_enabled").(bool)),
 		AutoMinorVersionUpgrade:     aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
-		CacheNodeType:               aws.String(d.Get("node_type").(string)),
-		Engine:                      aws.String(d.Get("engine").(string)),
 		Tags:                        tags,
 	}
 
+	if v, ok := d.GetOk("global_replication_group_id"); ok {
+		// Engine, node type and encryption settings are inherited from the Global Replication Group.
+		params.GlobalReplicationGroupId = aws.String(v.(string))
+	} else {
+		params.CacheNodeType = aws.String(d.Get("node_type").(string))
+		params.Engine = aws.String(d.Get("engine").(string))
+	}
+
 	if v, ok := d.GetOk("engine_version"); ok {
 		params.EngineVersion = aws.String(v.(string))
 	}
@@ -477,6 +501,12 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 		}
 	}
 
+	if rgp.GlobalReplicationGroupInfo != nil && rgp.GlobalReplicationGroupInfo.GlobalReplicationGroupId != nil {
+		d.Set("global_replication_group_id", rgp.GlobalReplicationGroupInfo.GlobalReplicationGroupId)
+	} else {
+		d.Set("global_replication_group_id", "")
+	}
+
 	d.Set("kms_key_id", rgp.KmsKeyId)
 	d.Set("replication_group_description", rgp.Description)
 	d.Set("number_cache_clusters", len(rgp.MemberClusters))
@@ -693,6 +723,21 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 func resourceAwsElasticacheReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
+	if globalReplicationGroupID, ok := d.GetOk("global_replication_group_id"); ok {
+		// A secondary member cannot be deleted while it is associated with a Global Replication Group.
+		member, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID.(string), d.Id())
+		if err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("error reading ElastiCache Replication Group (%s) Global Replication Group (%s) membership: %w", d.Id(), globalReplicationGroupID, err)
+		}
+
+		if member != nil && aws.StringValue(member.Role) == elasticacheGlobalReplicationGroupMemberRoleSecondary {
+			err := disassociateElasticacheReplicationGroup(conn, globalReplicationGroupID.(string), d.Id(), meta.(*AWSClient).region)
+			if err != nil {
+				return fmt.Errorf("error deleting ElastiCache Replication Group (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
 	var finalSnapshotID = d.Get("final_snapshot_identifier").(string)
 	err := deleteElasticacheReplicationGroup(d.Id(), conn, finalSnapshotID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsElb() *schema.Resource {
@@ -43,6 +44,7 @@ func resourceAwsElb() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc:  validateElbNamePrefix,
@@ -261,17 +263,12 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	var elbName string
-	if v, ok := d.GetOk("name"); ok {
-		elbName = v.(string)
-	} else {
-		if v, ok := d.GetOk("name_prefix"); ok {
-			elbName = resource.PrefixedUniqueId(v.(string))
-		} else {
-			elbName = resource.PrefixedUniqueId("tf-lb-")
-		}
-		d.Set("name", elbName)
+	namePrefix := d.Get("name_prefix").(string)
+	if namePrefix == "" {
+		namePrefix = "tf-lb-"
 	}
+	elbName := naming.Generate(d.Get("name").(string), namePrefix)
+	d.Set("name", elbName)
 
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ElbTags()
 
@@ -385,6 +382,7 @@ func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *el
 	lbAttrs := describeAttrsResp.LoadBalancerAttributes
 
 	d.Set("name", lb.LoadBalancerName)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(lb.LoadBalancerName)))
 	d.Set("dns_name", lb.DNSName)
 	d.Set("zone_id", lb.CanonicalHostedZoneNameID)
 
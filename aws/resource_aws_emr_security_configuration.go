@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsEMRSecurityConfiguration() *schema.Resource {
@@ -32,6 +33,7 @@ func resourceAwsEMRSecurityConfiguration() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc:  validation.StringLenBetween(0, 10280-resource.UniqueIDSuffixLength),
@@ -55,16 +57,11 @@ func resourceAwsEMRSecurityConfiguration() *schema.Resource {
 func resourceAwsEmrSecurityConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).emrconn
 
-	var emrSCName string
-	if v, ok := d.GetOk("name"); ok {
-		emrSCName = v.(string)
-	} else {
-		if v, ok := d.GetOk("name_prefix"); ok {
-			emrSCName = resource.PrefixedUniqueId(v.(string))
-		} else {
-			emrSCName = resource.PrefixedUniqueId("tf-emr-sc-")
-		}
+	namePrefix := d.Get("name_prefix").(string)
+	if namePrefix == "" {
+		namePrefix = "tf-emr-sc-"
 	}
+	emrSCName := naming.Generate(d.Get("name").(string), namePrefix)
 
 	resp, err := conn.CreateSecurityConfiguration(&emr.CreateSecurityConfigurationInput{
 		Name:                  aws.String(emrSCName),
@@ -96,6 +93,7 @@ func resourceAwsEmrSecurityConfigurationRead(d *schema.ResourceData, meta interf
 
 	d.Set("creation_date", aws.TimeValue(resp.CreationDateTime).Format(time.RFC3339))
 	d.Set("name", resp.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(resp.Name)))
 	d.Set("configuration", resp.SecurityConfiguration)
 
 	return nil
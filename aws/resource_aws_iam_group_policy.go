@@ -8,8 +8,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsIamGroupPolicy() *schema.Resource {
@@ -42,6 +42,7 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 			},
@@ -62,14 +63,7 @@ func resourceAwsIamGroupPolicyPut(d *schema.ResourceData, meta interface{}) erro
 		PolicyDocument: aws.String(d.Get("policy").(string)),
 	}
 
-	var policyName string
-	if v, ok := d.GetOk("name"); ok {
-		policyName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		policyName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		policyName = resource.UniqueId()
-	}
+	policyName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 	request.PolicyName = aws.String(policyName)
 
 	if _, err := iamconn.PutGroupPolicy(request); err != nil {
@@ -119,6 +113,7 @@ func resourceAwsIamGroupPolicyRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("name", name); err != nil {
 		return fmt.Errorf("error setting name: %s", err)
 	}
+	d.Set("name_prefix", naming.NamePrefixFromName(name))
 
 	if err := d.Set("group", group); err != nil {
 		return fmt.Errorf("error setting group: %s", err)
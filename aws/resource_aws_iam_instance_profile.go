@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsIamInstanceProfile() *schema.Resource {
@@ -46,6 +47,7 @@ func resourceAwsIamInstanceProfile() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc: validation.All(
@@ -74,14 +76,7 @@ func resourceAwsIamInstanceProfile() *schema.Resource {
 func resourceAwsIamInstanceProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
-	var name string
-	if v, ok := d.GetOk("name"); ok {
-		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
-	} else {
-		name = resource.UniqueId()
-	}
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	request := &iam.CreateInstanceProfileInput{
 		InstanceProfileName: aws.String(name),
@@ -254,6 +249,7 @@ func instanceProfileReadResult(d *schema.ResourceData, result *iam.InstanceProfi
 	if err := d.Set("name", result.InstanceProfileName); err != nil {
 		return err
 	}
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(result.InstanceProfileName)))
 	if err := d.Set("arn", result.Arn); err != nil {
 		return err
 	}
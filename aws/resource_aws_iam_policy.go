@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsIamPolicy() *schema.Resource {
@@ -56,6 +57,7 @@ func resourceAwsIamPolicy() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc: validation.All(
@@ -74,14 +76,7 @@ func resourceAwsIamPolicy() *schema.Resource {
 func resourceAwsIamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
-	var name string
-	if v, ok := d.GetOk("name"); ok {
-		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
-	} else {
-		name = resource.UniqueId()
-	}
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	request := &iam.CreatePolicyInput{
 		Description:    aws.String(d.Get("description").(string)),
@@ -146,6 +141,7 @@ func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", getPolicyResponse.Policy.Arn)
 	d.Set("description", getPolicyResponse.Policy.Description)
 	d.Set("name", getPolicyResponse.Policy.PolicyName)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(getPolicyResponse.Policy.PolicyName)))
 	d.Set("path", getPolicyResponse.Policy.Path)
 
 	// Retrieve policy
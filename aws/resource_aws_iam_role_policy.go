@@ -8,8 +8,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsIamRolePolicy() *schema.Resource {
@@ -42,6 +42,7 @@ func resourceAwsIamRolePolicy() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc:  validateIamRolePolicyNamePrefix,
@@ -63,14 +64,7 @@ func resourceAwsIamRolePolicyPut(d *schema.ResourceData, meta interface{}) error
 		PolicyDocument: aws.String(d.Get("policy").(string)),
 	}
 
-	var policyName string
-	if v, ok := d.GetOk("name"); ok {
-		policyName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		policyName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		policyName = resource.UniqueId()
-	}
+	policyName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 	request.PolicyName = aws.String(policyName)
 
 	if _, err := iamconn.PutRolePolicy(request); err != nil {
@@ -118,6 +112,7 @@ func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("name", name); err != nil {
 		return err
 	}
+	d.Set("name_prefix", naming.NamePrefixFromName(name))
 	return d.Set("role", role)
 }
 
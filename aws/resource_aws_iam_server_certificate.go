@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsIAMServerCertificate() *schema.Resource {
@@ -72,6 +73,7 @@ func resourceAwsIAMServerCertificate() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc:  validation.StringLenBetween(0, 128-resource.UniqueIDSuffixLength),
@@ -89,14 +91,7 @@ func resourceAwsIAMServerCertificate() *schema.Resource {
 func resourceAwsIAMServerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
-	var sslCertName string
-	if v, ok := d.GetOk("name"); ok {
-		sslCertName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		sslCertName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		sslCertName = resource.UniqueId()
-	}
+	sslCertName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	createOpts := &iam.UploadServerCertificateInput{
 		CertificateBody:       aws.String(d.Get("certificate_body").(string)),
@@ -144,6 +139,7 @@ func resourceAwsIAMServerCertificateRead(d *schema.ResourceData, meta interface{
 
 	d.Set("certificate_body", resp.ServerCertificate.CertificateBody)
 	d.Set("certificate_chain", resp.ServerCertificate.CertificateChain)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(resp.ServerCertificate.ServerCertificateMetadata.ServerCertificateName)))
 	d.Set("path", resp.ServerCertificate.ServerCertificateMetadata.Path)
 	d.Set("arn", resp.ServerCertificate.ServerCertificateMetadata.Arn)
 
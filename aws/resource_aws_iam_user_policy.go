@@ -8,8 +8,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsIamUserPolicy() *schema.Resource {
@@ -41,6 +41,7 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 			},
@@ -68,12 +69,8 @@ func resourceAwsIamUserPolicyPut(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			return err
 		}
-	} else if v, ok := d.GetOk("name"); ok {
-		policyName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		policyName = resource.PrefixedUniqueId(v.(string))
 	} else {
-		policyName = resource.UniqueId()
+		policyName = naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 	}
 	request.PolicyName = aws.String(policyName)
 
@@ -122,6 +119,7 @@ func resourceAwsIamUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("name", name); err != nil {
 		return err
 	}
+	d.Set("name_prefix", naming.NamePrefixFromName(name))
 	return d.Set("user", user)
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
@@ -274,7 +275,12 @@ func resourceAwsInstance() *schema.Resource {
 			},
 			"instance_state": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.InstanceStateNameRunning,
+					ec2.InstanceStateNameStopped,
+				}, false),
 			},
 			"instance_type": {
 				Type:     schema.TypeString,
@@ -511,7 +517,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data_base64"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
@@ -535,7 +540,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data"},
 				ValidateFunc: func(v interface{}, name string) (warns []string, errs []error) {
 					s := v.(string)
@@ -1230,73 +1234,104 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("instance_type") && !d.IsNewResource() {
-		log.Printf("[INFO] Stopping Instance %q for instance_type change", d.Id())
-		_, err := conn.StopInstances(&ec2.StopInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
+	// Some attributes can only be modified while the instance is stopped.
+	// Stop the instance, apply the modifications and then return the
+	// instance to its previous (or desired) state.
+	if d.HasChanges("instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
+		instance, err := finder.InstanceByID(conn, d.Id())
+
 		if err != nil {
-			return fmt.Errorf("error stopping instance (%s): %s", d.Id(), err)
+			return fmt.Errorf("error reading EC2 Instance (%s): %w", d.Id(), err)
 		}
 
-		if err := waitForInstanceStopping(conn, d.Id(), 10*time.Minute); err != nil {
-			return err
+		if instance == nil || instance.State == nil {
+			return fmt.Errorf("error reading EC2 Instance (%s): not found", d.Id())
 		}
 
-		log.Printf("[INFO] Modifying instance type %s", d.Id())
-		_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(d.Id()),
-			InstanceType: &ec2.AttributeValue{
-				Value: aws.String(d.Get("instance_type").(string)),
-			},
-		})
-		if err != nil {
-			return err
-		}
+		stateName := aws.StringValue(instance.State.Name)
+		restart := stateName == ec2.InstanceStateNamePending || stateName == ec2.InstanceStateNameRunning
 
-		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
+		if restart {
+			log.Printf("[INFO] Stopping EC2 Instance (%s) for attribute modification", d.Id())
+			if err := resourceAwsInstanceStop(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else if stateName == ec2.InstanceStateNameStopping {
+			// The instance is already on its way to stopped, wait for it to get there.
+			if _, err := waiter.InstanceStopped(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for EC2 Instance (%s) to stop: %w", d.Id(), err)
+			}
+		}
 
-		input := &ec2.StartInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
+		if d.HasChange("instance_type") {
+			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error modifying EC2 Instance (%s) instance type: %w", d.Id(), err)
+			}
 		}
 
-		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433
-		err = resource.Retry(waiter.InstanceAttributePropagationTimeout, func() *resource.RetryError {
-			_, err := conn.StartInstances(input)
+		if d.HasChanges("user_data", "user_data_base64") {
+			// The SDK base64 encodes the blob value, so the raw user data is sent.
+			var userData []byte
 
-			if tfawserr.ErrMessageContains(err, tfec2.ErrCodeInvalidParameterValue, "LaunchPlan instance type does not match attribute value") {
-				return resource.RetryableError(err)
+			if v, ok := d.GetOk("user_data"); ok {
+				userData = []byte(v.(string))
+			} else if v, ok := d.GetOk("user_data_base64"); ok {
+				userData, err = base64.StdEncoding.DecodeString(v.(string))
+
+				if err != nil {
+					return fmt.Errorf("error decoding user_data_base64: %w", err)
+				}
 			}
 
+			log.Printf("[INFO] Modifying instance user data %s", d.Id())
+			_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				UserData: &ec2.BlobAttributeValue{
+					Value: userData,
+				},
+			})
 			if err != nil {
-				return resource.NonRetryableError(err)
+				return fmt.Errorf("error modifying EC2 Instance (%s) user data: %w", d.Id(), err)
 			}
+		}
 
-			return nil
-		})
-
-		if tfresource.TimedOut(err) {
-			_, err = conn.StartInstances(input)
+		if restart && d.Get("instance_state").(string) != ec2.InstanceStateNameStopped {
+			log.Printf("[INFO] Starting EC2 Instance (%s) after attribute modification", d.Id())
+			if err := resourceAwsInstanceStart(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
 		}
+	}
+
+	if v, ok := d.GetOk("instance_state"); ok && (d.HasChange("instance_state") || d.IsNewResource()) {
+		instance, err := finder.InstanceByID(conn, d.Id())
 
 		if err != nil {
-			return fmt.Errorf("error starting EC2 Instance (%s): %w", d.Id(), err)
+			return fmt.Errorf("error reading EC2 Instance (%s): %w", d.Id(), err)
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
-			Target:     []string{ec2.InstanceStateNameRunning},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{ec2.InstanceStateNameTerminated}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+		if instance == nil || instance.State == nil {
+			return fmt.Errorf("error reading EC2 Instance (%s): not found", d.Id())
 		}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+		switch desired, current := v.(string), aws.StringValue(instance.State.Name); {
+		case desired == ec2.InstanceStateNameStopped && current != ec2.InstanceStateNameStopped:
+			log.Printf("[INFO] Stopping EC2 Instance (%s)", d.Id())
+			if err := resourceAwsInstanceStop(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		case desired == ec2.InstanceStateNameRunning && current != ec2.InstanceStateNameRunning:
+			log.Printf("[INFO] Starting EC2 Instance (%s)", d.Id())
+			if err := resourceAwsInstanceStart(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -2424,6 +2459,59 @@ func awsTerminateInstance(conn *ec2.EC2, id string, timeout time.Duration) error
 	return waitForInstanceDeletion(conn, id, timeout)
 }
 
+// resourceAwsInstanceStop stops an EC2 Instance and waits for it to reach the stopped state.
+func resourceAwsInstanceStop(conn *ec2.EC2, id string, timeout time.Duration) error {
+	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping EC2 Instance (%s): %w", id, err)
+	}
+
+	if _, err := waiter.InstanceStopped(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to stop: %w", id, err)
+	}
+
+	return nil
+}
+
+// resourceAwsInstanceStart starts an EC2 Instance and waits for it to reach the running state.
+func resourceAwsInstanceStart(conn *ec2.EC2, id string, timeout time.Duration) error {
+	input := &ec2.StartInstancesInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433
+	err := resource.Retry(waiter.InstanceAttributePropagationTimeout, func() *resource.RetryError {
+		_, err := conn.StartInstances(input)
+
+		if tfawserr.ErrMessageContains(err, tfec2.ErrCodeInvalidParameterValue, "LaunchPlan instance type does not match attribute value") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.StartInstances(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error starting EC2 Instance (%s): %w", id, err)
+	}
+
+	if _, err := waiter.InstanceRunning(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to start: %w", id, err)
+	}
+
+	return nil
+}
+
 func waitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become stopped", id)
 
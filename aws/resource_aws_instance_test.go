@@ -1582,6 +1582,109 @@ func TestAccAWSInstance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_InstanceState(t *testing.T) {
+	var v1, v2, v3 ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigInstanceState(rName, ec2.InstanceStateNameStopped),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", ec2.InstanceStateNameStopped),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceConfigInstanceState(rName, ec2.InstanceStateNameRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckInstanceNotRecreated(t, &v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", ec2.InstanceStateNameRunning),
+				),
+			},
+			{
+				Config: testAccInstanceConfigInstanceState(rName, ec2.InstanceStateNameStopped),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v3),
+					testAccCheckInstanceNotRecreated(t, &v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", ec2.InstanceStateNameStopped),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_InstanceState_changeInstanceType(t *testing.T) {
+	var before, after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigInstanceStateInstanceType(rName, ec2.InstanceStateNameStopped, "t2.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", ec2.InstanceStateNameStopped),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.medium"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigInstanceStateInstanceType(rName, ec2.InstanceStateNameStopped, "t2.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", ec2.InstanceStateNameStopped),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.large"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_UserData_Update(t *testing.T) {
+	var before, after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigUserData(rName, "hello world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "user_data", userDataHashSum("hello world")),
+				),
+			},
+			{
+				Config: testAccInstanceConfigUserData(rName, "goodbye world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "user_data", userDataHashSum("goodbye world")),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", ec2.InstanceStateNameRunning),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_EbsRootDevice_basic(t *testing.T) {
 	var instance ec2.Instance
 	resourceName := "aws_instance.test"
@@ -3647,6 +3750,60 @@ resource "aws_instance" "test" {
 `)
 }
 
+func testAccInstanceConfigInstanceState(rName, state string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAwsInstanceVpcConfig(rName, false),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami            = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  subnet_id      = aws_subnet.test.id
+  instance_type  = "t2.small"
+  instance_state = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, state))
+}
+
+func testAccInstanceConfigInstanceStateInstanceType(rName, state, instanceType string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAwsInstanceVpcConfig(rName, false),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami            = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  subnet_id      = aws_subnet.test.id
+  instance_type  = %[3]q
+  instance_state = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, state, instanceType))
+}
+
+func testAccInstanceConfigUserData(rName, userData string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAwsInstanceVpcConfig(rName, false),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  subnet_id     = aws_subnet.test.id
+  instance_type = "t2.small"
+  user_data     = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, userData))
+}
+
 func testAccInstanceConfigWithSmallInstanceType(rName string) string {
 	return composeConfig(
 		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesis/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSKinesisStreamConsumer_basic(t *testing.T) {
+	var consumer kinesis.ConsumerDescription
+	resourceName := "aws_kinesis_stream_consumer.test"
+	streamName := "aws_kinesis_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName, &consumer),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesis", regexp.MustCompile(fmt.Sprintf("stream/%s/consumer/%s:.+", rName, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "stream_arn", streamName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisStreamConsumer_disappears(t *testing.T) {
+	var consumer kinesis.ConsumerDescription
+	resourceName := "aws_kinesis_stream_consumer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName, &consumer),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsKinesisStreamConsumer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisStreamConsumer_MultipleConsumers(t *testing.T) {
+	var consumer1, consumer2 kinesis.ConsumerDescription
+	resourceName1 := "aws_kinesis_stream_consumer.test.0"
+	resourceName2 := "aws_kinesis_stream_consumer.test.1"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerConfigMultiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName1, &consumer1),
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName2, &consumer2),
+					resource.TestCheckResourceAttr(resourceName1, "name", fmt.Sprintf("%s-0", rName)),
+					resource.TestCheckResourceAttr(resourceName2, "name", fmt.Sprintf("%s-1", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKinesisStreamConsumerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_stream_consumer" {
+			continue
+		}
+
+		_, err := finder.StreamConsumerByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Stream Consumer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKinesisStreamConsumerExists(n string, v *kinesis.ConsumerDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Stream Consumer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+		output, err := finder.StreamConsumerByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSKinesisStreamConsumerConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccAWSKinesisStreamConsumerConfig(rName string) string {
+	return composeConfig(
+		testAccAWSKinesisStreamConsumerConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %[1]q
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, rName))
+}
+
+func testAccAWSKinesisStreamConsumerConfigMultiple(rName string) string {
+	return composeConfig(
+		testAccAWSKinesisStreamConsumerConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  count = 2
+
+  name       = "%[1]s-${count.index}"
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, rName))
+}
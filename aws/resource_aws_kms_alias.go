@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsKmsAlias() *schema.Resource {
@@ -39,6 +40,7 @@ func resourceAwsKmsAlias() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc:  validateAwsKmsName,
@@ -59,14 +61,11 @@ func resourceAwsKmsAlias() *schema.Resource {
 func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
 
-	var name string
-	if v, ok := d.GetOk("name"); ok {
-		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
-	} else {
-		name = resource.PrefixedUniqueId("alias/")
+	namePrefix := d.Get("name_prefix").(string)
+	if namePrefix == "" {
+		namePrefix = "alias/"
 	}
+	name := naming.Generate(d.Get("name").(string), namePrefix)
 
 	targetKeyId := d.Get("target_key_id").(string)
 
@@ -110,6 +109,8 @@ func resourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Found KMS Alias: %s", alias)
 
 	d.Set("arn", alias.AliasArn)
+	d.Set("name", alias.AliasName)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(alias.AliasName)))
 	d.Set("target_key_id", alias.TargetKeyId)
 
 	aliasARN, err := arn.Parse(*alias.AliasArn)
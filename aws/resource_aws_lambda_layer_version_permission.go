@@ -0,0 +1,320 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tflambda "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lambda"
+)
+
+func resourceAwsLambdaLayerVersionPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLambdaLayerVersionPermissionCreate,
+		Read:   resourceAwsLambdaLayerVersionPermissionRead,
+		Delete: resourceAwsLambdaLayerVersionPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLambdaLayerVersionPermissionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"lambda:GetLayerVersion",
+				}, false),
+			},
+			"layer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"organization_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[0-9a-z]{10,32}$`), "must be a valid AWS Organizations ID"),
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statement_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyStatementId,
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLambdaLayerVersionPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	layerARN := d.Get("layer_arn").(string)
+	layerName, err := lambdaLayerNameFromArn(layerARN)
+
+	if err != nil {
+		return err
+	}
+
+	versionNumber := int64(d.Get("version_number").(int))
+	statementID := d.Get("statement_id").(string)
+
+	input := &lambda.AddLayerVersionPermissionInput{
+		Action:        aws.String(d.Get("action").(string)),
+		LayerName:     aws.String(layerARN),
+		Principal:     aws.String(d.Get("principal").(string)),
+		StatementId:   aws.String(statementID),
+		VersionNumber: aws.Int64(versionNumber),
+	}
+
+	if v, ok := d.GetOk("organization_id"); ok {
+		input.OrganizationId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Adding Lambda Layer Version permission: %s", input)
+	_, err = conn.AddLayerVersionPermission(input)
+
+	if err != nil {
+		return fmt.Errorf("error adding Lambda Layer Version (%s:%d) permission (%s): %w", layerARN, versionNumber, statementID, err)
+	}
+
+	d.SetId(tflambda.LayerVersionPermissionCreateResourceID(layerName, versionNumber, statementID))
+
+	return resourceAwsLambdaLayerVersionPermissionRead(d, meta)
+}
+
+func resourceAwsLambdaLayerVersionPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	layerName, versionNumber, statementID, err := tflambda.LayerVersionPermissionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetLayerVersionPolicy(&lambda.GetLayerVersionPolicyInput{
+		LayerName:     aws.String(layerName),
+		VersionNumber: aws.Int64(versionNumber),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Lambda Layer Version (%s) policy not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Layer Version (%s) policy: %w", d.Id(), err)
+	}
+
+	policy := LambdaLayerVersionPolicy{}
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), &policy); err != nil {
+		return fmt.Errorf("error unmarshalling Lambda Layer Version (%s) policy: %w", d.Id(), err)
+	}
+
+	statement := policy.statement(statementID)
+
+	if statement == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lambda Layer Version (%s) policy: statement %q not found", d.Id(), statementID)
+		}
+
+		log.Printf("[WARN] Lambda Layer Version (%s) policy statement not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	principal, err := statement.principal()
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Layer Version (%s) policy: %w", d.Id(), err)
+	}
+
+	// Account IDs are stored in the policy as root principal ARNs.
+	if v := d.Get("principal").(string); v != "" && principal == lambdaLayerVersionPermissionAccountPrincipal(v, meta.(*AWSClient).partition) {
+		principal = v
+	}
+
+	// The statement resource is the layer version ARN.
+	layerARN := statement.Resource
+	if i := strings.LastIndex(layerARN, ":"); i > 0 {
+		layerARN = layerARN[:i]
+	}
+
+	d.Set("action", statement.Action)
+	d.Set("layer_arn", layerARN)
+	d.Set("organization_id", statement.Condition["StringEquals"]["aws:PrincipalOrgID"])
+	d.Set("policy", output.Policy)
+	d.Set("principal", principal)
+	d.Set("revision_id", output.RevisionId)
+	d.Set("statement_id", statement.Sid)
+	d.Set("version_number", versionNumber)
+
+	return nil
+}
+
+func resourceAwsLambdaLayerVersionPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	layerName, versionNumber, statementID, err := tflambda.LayerVersionPermissionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Removing Lambda Layer Version permission: %s", d.Id())
+	_, err = conn.RemoveLayerVersionPermission(&lambda.RemoveLayerVersionPermissionInput{
+		LayerName:     aws.String(layerName),
+		StatementId:   aws.String(statementID),
+		VersionNumber: aws.Int64(versionNumber),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing Lambda Layer Version permission (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsLambdaLayerVersionPermissionImport accepts either
+// LAYER_NAME,VERSION_NUMBER,STATEMENT_ID or, for layer versions with a single
+// policy statement, LAYER_NAME,VERSION_NUMBER.
+func resourceAwsLambdaLayerVersionPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).lambdaconn
+
+	layerName, versionNumber, statementID, err := tflambda.LayerVersionPermissionParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if statementID == "" {
+		output, err := conn.GetLayerVersionPolicy(&lambda.GetLayerVersionPolicyInput{
+			LayerName:     aws.String(layerName),
+			VersionNumber: aws.Int64(versionNumber),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading Lambda Layer Version (%s) policy: %w", d.Id(), err)
+		}
+
+		policy := LambdaLayerVersionPolicy{}
+		if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), &policy); err != nil {
+			return nil, fmt.Errorf("error unmarshalling Lambda Layer Version (%s) policy: %w", d.Id(), err)
+		}
+
+		if len(policy.Statement) != 1 {
+			return nil, fmt.Errorf("Lambda Layer Version (%s) policy has %d statements, import using LAYER_NAME,VERSION_NUMBER,STATEMENT_ID", d.Id(), len(policy.Statement))
+		}
+
+		statementID = policy.Statement[0].Sid
+	}
+
+	d.SetId(tflambda.LayerVersionPermissionCreateResourceID(layerName, versionNumber, statementID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func lambdaLayerNameFromArn(layerARN string) (string, error) {
+	parsedARN, err := arn.Parse(layerARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing Lambda Layer ARN (%s): %w", layerARN, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, ":")
+
+	if len(parts) != 2 || parts[0] != "layer" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for Lambda Layer ARN (%s), expected arn:PARTITION:lambda:REGION:ACCOUNT_ID:layer:LAYER_NAME", layerARN)
+	}
+
+	return parts[1], nil
+}
+
+// lambdaLayerVersionPermissionAccountPrincipal returns the principal that a
+// policy statement records for the given account ID.
+func lambdaLayerVersionPermissionAccountPrincipal(accountID, partition string) string {
+	if !regexp.MustCompile(`^\d{12}$`).MatchString(accountID) {
+		return accountID
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   "iam",
+		AccountID: accountID,
+		Resource:  "root",
+	}.String()
+}
+
+type LambdaLayerVersionPolicy struct {
+	Version   string
+	Statement []LambdaLayerVersionPolicyStatement
+	Id        string
+}
+
+func (policy LambdaLayerVersionPolicy) statement(sid string) *LambdaLayerVersionPolicyStatement {
+	for i := range policy.Statement {
+		if policy.Statement[i].Sid == sid {
+			return &policy.Statement[i]
+		}
+	}
+
+	return nil
+}
+
+type LambdaLayerVersionPolicyStatement struct {
+	Condition map[string]map[string]string
+	Action    string
+	Resource  string
+	Effect    string
+	Principal interface{}
+	Sid       string
+}
+
+// principal returns the statement principal, which is either "*" or an
+// {"AWS": "..."} map.
+func (statement LambdaLayerVersionPolicyStatement) principal() (string, error) {
+	switch v := statement.Principal.(type) {
+	case string:
+		return v, nil
+	case map[string]interface{}:
+		if principal, ok := v["AWS"].(string); ok {
+			return principal, nil
+		}
+	}
+
+	return "", fmt.Errorf("unexpected principal in policy statement %q: %v", statement.Sid, statement.Principal)
+}
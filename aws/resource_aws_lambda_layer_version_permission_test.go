@@ -0,0 +1,274 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflambda "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lambda"
+)
+
+func TestAccAWSLambdaLayerVersionPermission_all(t *testing.T) {
+	resourceName := "aws_lambda_layer_version_permission.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLambdaLayerVersionPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaLayerVersionPermissionConfig_all(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaLayerVersionPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "lambda:GetLayerVersion"),
+					resource.TestCheckResourceAttr(resourceName, "principal", "*"),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "xaccount"),
+					resource.TestCheckResourceAttr(resourceName, "organization_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "layer_arn", "aws_lambda_layer_version.test", "layer_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_number", "aws_lambda_layer_version.test", "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSLambdaLayerVersionPermissionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLambdaLayerVersionPermission_account(t *testing.T) {
+	resourceName := "aws_lambda_layer_version_permission.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLambdaLayerVersionPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaLayerVersionPermissionConfig_account(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaLayerVersionPermissionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s,1,xaccount", rName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"principal"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLambdaLayerVersionPermission_org(t *testing.T) {
+	resourceName := "aws_lambda_layer_version_permission.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsEnabledPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLambdaLayerVersionPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaLayerVersionPermissionConfig_org(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaLayerVersionPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "principal", "*"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "data.aws_organizations_organization.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSLambdaLayerVersionPermissionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLambdaLayerVersionPermission_disappears(t *testing.T) {
+	resourceName := "aws_lambda_layer_version_permission.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLambdaLayerVersionPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaLayerVersionPermissionConfig_all(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaLayerVersionPermissionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLambdaLayerVersionPermission(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccAWSLambdaLayerVersionPermissionImportStateIdFunc returns the
+// LAYER_NAME,VERSION_NUMBER import ID, which is valid for layer versions with a
+// single policy statement.
+func testAccAWSLambdaLayerVersionPermissionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		layerName, versionNumber, _, err := tflambda.LayerVersionPermissionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s,%d", layerName, versionNumber), nil
+	}
+}
+
+func testAccCheckAwsLambdaLayerVersionPermissionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Layer Version Permission ID is set")
+		}
+
+		statement, err := testAccAwsLambdaLayerVersionPermissionStatement(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if statement == nil {
+			return fmt.Errorf("Lambda Layer Version Permission (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLambdaLayerVersionPermissionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_layer_version_permission" {
+			continue
+		}
+
+		statement, err := testAccAwsLambdaLayerVersionPermissionStatement(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if statement != nil {
+			return fmt.Errorf("Lambda Layer Version Permission (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsLambdaLayerVersionPermissionStatement(id string) (*LambdaLayerVersionPolicyStatement, error) {
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+
+	layerName, versionNumber, statementID, err := tflambda.LayerVersionPermissionParseResourceID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := conn.GetLayerVersionPolicy(&lambda.GetLayerVersionPolicyInput{
+		LayerName:     aws.String(layerName),
+		VersionNumber: aws.Int64(versionNumber),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy := LambdaLayerVersionPolicy{}
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), &policy); err != nil {
+		return nil, err
+	}
+
+	return policy.statement(statementID), nil
+}
+
+func testAccAWSLambdaLayerVersionPermissionConfigBase(layerName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename   = "test-fixtures/lambdatest.zip"
+  layer_name = %[1]q
+}
+`, layerName)
+}
+
+func testAccAWSLambdaLayerVersionPermissionConfig_all(layerName string) string {
+	return composeConfig(
+		testAccAWSLambdaLayerVersionPermissionConfigBase(layerName),
+		`
+resource "aws_lambda_layer_version_permission" "test" {
+  layer_arn      = aws_lambda_layer_version.test.layer_arn
+  version_number = aws_lambda_layer_version.test.version
+  action         = "lambda:GetLayerVersion"
+  statement_id   = "xaccount"
+  principal      = "*"
+}
+`)
+}
+
+func testAccAWSLambdaLayerVersionPermissionConfig_account(layerName string) string {
+	return composeConfig(
+		testAccAWSLambdaLayerVersionPermissionConfigBase(layerName),
+		`
+data "aws_caller_identity" "current" {}
+
+resource "aws_lambda_layer_version_permission" "test" {
+  layer_arn      = aws_lambda_layer_version.test.layer_arn
+  version_number = aws_lambda_layer_version.test.version
+  action         = "lambda:GetLayerVersion"
+  statement_id   = "xaccount"
+  principal      = data.aws_caller_identity.current.account_id
+}
+`)
+}
+
+func testAccAWSLambdaLayerVersionPermissionConfig_org(layerName string) string {
+	return composeConfig(
+		testAccAWSLambdaLayerVersionPermissionConfigBase(layerName),
+		`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_lambda_layer_version_permission" "test" {
+  layer_arn       = aws_lambda_layer_version.test.layer_arn
+  version_number  = aws_lambda_layer_version.test.version
+  action          = "lambda:GetLayerVersion"
+  statement_id    = "xaccount"
+  principal       = "*"
+  organization_id = data.aws_organizations_organization.test.id
+}
+`)
+}
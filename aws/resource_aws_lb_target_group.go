@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsLbTargetGroup() *schema.Resource {
@@ -53,6 +54,7 @@ func resourceAwsLbTargetGroup() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc:  validateLbTargetGroupNamePrefix,
@@ -280,14 +282,11 @@ func suppressIfTargetType(t string) schema.SchemaDiffSuppressFunc {
 func resourceAwsLbTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
-	var groupName string
-	if v, ok := d.GetOk("name"); ok {
-		groupName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		groupName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		groupName = resource.PrefixedUniqueId("tf-")
+	namePrefix := d.Get("name_prefix").(string)
+	if namePrefix == "" {
+		namePrefix = "tf-"
 	}
+	groupName := naming.Generate(d.Get("name").(string), namePrefix)
 
 	params := &elbv2.CreateTargetGroupInput{
 		Name:       aws.String(groupName),
@@ -628,6 +627,7 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 	d.Set("arn", targetGroup.TargetGroupArn)
 	d.Set("arn_suffix", lbTargetGroupSuffixFromARN(targetGroup.TargetGroupArn))
 	d.Set("name", targetGroup.TargetGroupName)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(targetGroup.TargetGroupName)))
 	d.Set("target_type", targetGroup.TargetType)
 
 	healthCheck := make(map[string]interface{})
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsLightsailKeyPair() *schema.Resource {
@@ -30,6 +31,7 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 			},
@@ -79,14 +81,7 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
-	var kName string
-	if v, ok := d.GetOk("name"); ok {
-		kName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		kName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		kName = resource.UniqueId()
-	}
+	kName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	var pubKey string
 	var op *lightsail.Operation
@@ -189,6 +184,7 @@ func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) e
 
 	d.Set("arn", resp.KeyPair.Arn)
 	d.Set("name", resp.KeyPair.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(resp.KeyPair.Name)))
 	d.Set("fingerprint", resp.KeyPair.Fingerprint)
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsNeptuneEventSubscription() *schema.Resource {
@@ -86,13 +87,11 @@ func resourceAwsNeptuneEventSubscription() *schema.Resource {
 func resourceAwsNeptuneEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).neptuneconn
 
-	if v, ok := d.GetOk("name"); ok {
-		d.Set("name", v.(string))
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		d.Set("name", resource.PrefixedUniqueId(v.(string)))
-	} else {
-		d.Set("name", resource.PrefixedUniqueId("tf-"))
+	namePrefix := d.Get("name_prefix").(string)
+	if namePrefix == "" {
+		namePrefix = "tf-"
 	}
+	d.Set("name", naming.Generate(d.Get("name").(string), namePrefix))
 
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().NeptuneTags()
 
@@ -171,6 +170,7 @@ func resourceAwsNeptuneEventSubscriptionRead(d *schema.ResourceData, meta interf
 
 	d.Set("arn", sub.EventSubscriptionArn)
 	d.Set("name", sub.CustSubscriptionId)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(sub.CustSubscriptionId)))
 	d.Set("sns_topic_arn", sub.SnsTopicArn)
 	d.Set("enabled", sub.Enabled)
 	d.Set("customer_aws_id", sub.CustomerAwsId)
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tforganizations "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations/finder"
+)
+
+func resourceAwsOrganizationsDelegatedAdministrator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsDelegatedAdministratorCreate,
+		Read:   resourceAwsOrganizationsDelegatedAdministratorRead,
+		Delete: resourceAwsOrganizationsDelegatedAdministratorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delegation_enabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID := d.Get("account_id").(string)
+	servicePrincipal := d.Get("service_principal").(string)
+
+	input := &organizations.RegisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	_, err := conn.RegisterDelegatedAdministrator(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering Organizations Delegated Administrator (%s/%s): %w", accountID, servicePrincipal, err)
+	}
+
+	d.SetId(tforganizations.DelegatedAdministratorCreateResourceID(accountID, servicePrincipal))
+
+	return resourceAwsOrganizationsDelegatedAdministratorRead(d, meta)
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := tforganizations.DelegatedAdministratorParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	delegatedAdministrator, err := finder.DelegatedAdministrator(conn, accountID, servicePrincipal)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		log.Printf("[WARN] Organizations Delegated Administrator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Delegated Administrator (%s): %w", d.Id(), err)
+	}
+
+	if delegatedAdministrator == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Organizations Delegated Administrator (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Organizations Delegated Administrator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", delegatedAdministrator.Arn)
+	d.Set("delegation_enabled_date", aws.TimeValue(delegatedAdministrator.DelegationEnabledDate).Format(time.RFC3339))
+	d.Set("email", delegatedAdministrator.Email)
+	d.Set("joined_method", delegatedAdministrator.JoinedMethod)
+	d.Set("joined_timestamp", aws.TimeValue(delegatedAdministrator.JoinedTimestamp).Format(time.RFC3339))
+	d.Set("name", delegatedAdministrator.Name)
+	d.Set("service_principal", servicePrincipal)
+	d.Set("status", delegatedAdministrator.Status)
+
+	return nil
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := tforganizations.DelegatedAdministratorParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.DeregisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	_, err = conn.DeregisterDelegatedAdministrator(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotRegisteredException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering Organizations Delegated Administrator (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tforganizations "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations/finder"
+)
+
+func testAccAwsOrganizationsDelegatedAdministrator_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var delegatedAdministrator organizations.DelegatedAdministrator
+	resourceName := "aws_organizations_delegated_administrator.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+	dataSourceIdentity := "data.aws_caller_identity.delegated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationsEnabledPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName, &delegatedAdministrator),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceIdentity, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					testAccCheckResourceAttrRfc3339(resourceName, "delegation_enabled_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "email"),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_method"),
+					testAccCheckResourceAttrRfc3339(resourceName, "joined_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "service_principal", servicePrincipal),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsOrganizationsDelegatedAdministrator_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var delegatedAdministrator organizations.DelegatedAdministrator
+	resourceName := "aws_organizations_delegated_administrator.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationsEnabledPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName, &delegatedAdministrator),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsOrganizationsDelegatedAdministrator(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_delegated_administrator" {
+			continue
+		}
+
+		accountID, servicePrincipal, err := tforganizations.DelegatedAdministratorParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		delegatedAdministrator, err := finder.DelegatedAdministrator(conn, accountID, servicePrincipal)
+
+		if err != nil {
+			return err
+		}
+
+		if delegatedAdministrator != nil {
+			return fmt.Errorf("Organizations Delegated Administrator (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorExists(n string, v *organizations.DelegatedAdministrator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Organizations Delegated Administrator ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+		accountID, servicePrincipal, err := tforganizations.DelegatedAdministratorParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		delegatedAdministrator, err := finder.DelegatedAdministrator(conn, accountID, servicePrincipal)
+
+		if err != nil {
+			return err
+		}
+
+		if delegatedAdministrator == nil {
+			return fmt.Errorf("Organizations Delegated Administrator (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *delegatedAdministrator
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal string) string {
+	return composeConfig(
+		testAccAlternateAccountProviderConfig(),
+		fmt.Sprintf(`
+data "aws_caller_identity" "delegated" {
+  provider = "awsalternate"
+}
+
+resource "aws_organizations_delegated_administrator" "test" {
+  account_id        = data.aws_caller_identity.delegated.account_id
+  service_principal = %[1]q
+}
+`, servicePrincipal))
+}
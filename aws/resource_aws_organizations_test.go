@@ -20,6 +20,16 @@ func TestAccAWSOrganizations_serial(t *testing.T) {
 			"ParentId": testAccAwsOrganizationsAccount_ParentId,
 			"Tags":     testAccAwsOrganizationsAccount_Tags,
 		},
+		"DelegatedAdministrator": {
+			"basic":      testAccAwsOrganizationsDelegatedAdministrator_basic,
+			"disappears": testAccAwsOrganizationsDelegatedAdministrator_disappears,
+		},
+		"DelegatedAdministrators": {
+			"DataSource": testAccDataSourceAwsOrganizationsDelegatedAdministrators_basic,
+		},
+		"DelegatedServices": {
+			"DataSource": testAccDataSourceAwsOrganizationsDelegatedServices_basic,
+		},
 		"OrganizationalUnit": {
 			"basic": testAccAwsOrganizationsOrganizationalUnit_basic,
 			"Name":  testAccAwsOrganizationsOrganizationalUnit_Name,
@@ -6,10 +6,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/pinpoint"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsPinpointApp() *schema.Resource {
@@ -33,6 +33,7 @@ func resourceAwsPinpointApp() *schema.Resource {
 			"name_prefix": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"application_id": {
@@ -145,15 +146,7 @@ func resourceAwsPinpointApp() *schema.Resource {
 func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) error {
 	pinpointconn := meta.(*AWSClient).pinpointconn
 
-	var name string
-
-	if v, ok := d.GetOk("name"); ok {
-		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
-	} else {
-		name = resource.UniqueId()
-	}
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	log.Printf("[DEBUG] Pinpoint create app: %s", name)
 
@@ -257,6 +250,7 @@ func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error
 
 	arn := aws.StringValue(app.ApplicationResponse.Arn)
 	d.Set("name", app.ApplicationResponse.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(app.ApplicationResponse.Name)))
 	d.Set("application_id", app.ApplicationResponse.Id)
 	d.Set("arn", arn)
 
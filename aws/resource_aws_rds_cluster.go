@@ -415,6 +415,7 @@ func resourceAwsRDSCluster() *schema.Resource {
 			"iam_roles": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
@@ -0,0 +1,653 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/waiter"
+)
+
+func resourceAwsRoute53Records() *schema.Resource {
+	recordResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluate_target_health": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return normalizeAwsAliasName(old) == normalizeAwsAliasName(new)
+							},
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"zone_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+					},
+				},
+			},
+			"failover_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+						},
+					},
+				},
+			},
+			"geolocation_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"continent": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"subdivision": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"health_check_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"latency_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"multivalue_answer_routing_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRoute53RecordsName,
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"set_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"weighted_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"weight": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsRoute53RecordsCreate,
+		Read:   resourceAwsRoute53RecordsRead,
+		Update: resourceAwsRoute53RecordsUpdate,
+		Delete: resourceAwsRoute53RecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name_suffix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRoute53RecordsName,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     recordResource,
+				Set:      route53RecordsRecordHash(recordResource),
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53RecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	zoneID := cleanZoneID(d.Get("zone_id").(string))
+	nameSuffix := d.Get("name_suffix").(string)
+
+	zoneName, err := route53HostedZoneName(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	d.SetId(tfroute53.RecordsCreateResourceID(zoneID, nameSuffix))
+
+	if err := route53RecordsSync(conn, zoneID, zoneName, nameSuffix, d.Get("record").(*schema.Set)); err != nil {
+		return fmt.Errorf("error creating Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecordsRead(d, meta)
+}
+
+func resourceAwsRoute53RecordsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	zoneID, nameSuffix, err := tfroute53.RecordsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	zoneName, err := route53HostedZoneName(conn, zoneID)
+
+	if !d.IsNewResource() && isAWSErr(err, route53.ErrCodeNoSuchHostedZone, "") {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing from state", zoneID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	resourceRecordSets, err := finder.ResourceRecordSets(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	var records []interface{}
+
+	for _, resourceRecordSet := range resourceRecordSets {
+		if !route53RecordsOwned(resourceRecordSet, zoneName, nameSuffix) {
+			continue
+		}
+
+		records = append(records, flattenRoute53RecordsRecord(resourceRecordSet))
+	}
+
+	d.Set("name_suffix", nameSuffix)
+
+	if err := d.Set("record", records); err != nil {
+		return fmt.Errorf("error setting record: %w", err)
+	}
+
+	d.Set("zone_id", zoneID)
+
+	return nil
+}
+
+func resourceAwsRoute53RecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	zoneID, nameSuffix, err := tfroute53.RecordsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("record") {
+		zoneName, err := route53HostedZoneName(conn, zoneID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+		}
+
+		if err := route53RecordsSync(conn, zoneID, zoneName, nameSuffix, d.Get("record").(*schema.Set)); err != nil {
+			return fmt.Errorf("error updating Route 53 Records (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53RecordsRead(d, meta)
+}
+
+func resourceAwsRoute53RecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	zoneID, nameSuffix, err := tfroute53.RecordsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	zoneName, err := route53HostedZoneName(conn, zoneID)
+
+	if isAWSErr(err, route53.ErrCodeNoSuchHostedZone, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	// Deleting the resource removes every record that it owns in the hosted zone.
+	desired := schema.NewSet(d.Get("record").(*schema.Set).F, nil)
+
+	if err := route53RecordsSync(conn, zoneID, zoneName, nameSuffix, desired); err != nil {
+		return fmt.Errorf("error deleting Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// route53HostedZoneName returns the lowercase name of the specified hosted zone without the trailing period.
+func route53HostedZoneName(conn *route53.Route53, zoneID string) (string, error) {
+	output, err := conn.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(zoneID),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.HostedZone == nil {
+		return "", fmt.Errorf("empty result")
+	}
+
+	return strings.ToLower(strings.TrimSuffix(aws.StringValue(output.HostedZone.Name), ".")), nil
+}
+
+// route53RecordsSync makes the records owned by the resource in the hosted zone match the desired records.
+// The current records are read with a single paginated listing and the changes are applied in as few
+// change batches as the request limits allow, waiting for each batch to be in sync before sending the next.
+func route53RecordsSync(conn *route53.Route53, zoneID, zoneName, nameSuffix string, desired *schema.Set) error {
+	resourceRecordSets, err := finder.ResourceRecordSets(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("error listing records: %w", err)
+	}
+
+	current := make(map[route53RecordsKey]*route53.ResourceRecordSet)
+
+	for _, resourceRecordSet := range resourceRecordSets {
+		if !route53RecordsOwned(resourceRecordSet, zoneName, nameSuffix) {
+			continue
+		}
+
+		current[newRoute53RecordsKey(resourceRecordSet)] = resourceRecordSet
+	}
+
+	var deletes, upserts []*route53.Change
+	wanted := make(map[route53RecordsKey]struct{})
+
+	for _, tfMapRaw := range desired.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		resourceRecordSet, err := expandRoute53RecordsRecord(tfMap)
+
+		if err != nil {
+			return err
+		}
+
+		if !route53RecordsOwned(resourceRecordSet, zoneName, nameSuffix) {
+			return fmt.Errorf("record %s (%s) is not within the records managed by this resource", aws.StringValue(resourceRecordSet.Name), aws.StringValue(resourceRecordSet.Type))
+		}
+
+		key := newRoute53RecordsKey(resourceRecordSet)
+
+		if _, ok := wanted[key]; ok {
+			return fmt.Errorf("duplicate record %s (%s) with set identifier %q", aws.StringValue(resourceRecordSet.Name), aws.StringValue(resourceRecordSet.Type), aws.StringValue(resourceRecordSet.SetIdentifier))
+		}
+
+		wanted[key] = struct{}{}
+
+		if v, ok := current[key]; ok && desired.F(flattenRoute53RecordsRecord(v)) == desired.F(tfMap) {
+			continue
+		}
+
+		upserts = append(upserts, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: resourceRecordSet,
+		})
+	}
+
+	// Unmanaged records are removed. Deletions are sent before any upserts so that
+	// conflicting records (e.g. a CNAME being replaced by an A record) are gone first.
+	for key, resourceRecordSet := range current {
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+
+		deletes = append(deletes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: resourceRecordSet,
+		})
+	}
+
+	for _, changes := range tfroute53.SplitChanges(append(deletes, upserts...)) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: changes,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Changing %d Route 53 Records in Hosted Zone (%s)", len(changes), zoneID)
+		outputRaw, err := changeRoute53RecordSet(conn, input)
+
+		if err != nil {
+			return fmt.Errorf("error changing records: %w", err)
+		}
+
+		changeInfo := outputRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
+
+		if changeInfo == nil {
+			continue
+		}
+
+		if _, err := waiter.ChangeInfoStatusInsync(conn, cleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return fmt.Errorf("error waiting for record changes (%s) to sync: %w", aws.StringValue(changeInfo.Id), err)
+		}
+	}
+
+	return nil
+}
+
+// route53RecordsOwned returns whether the specified record is owned by an aws_route53_records resource.
+// The SOA and NS records at the zone apex are never owned as they cannot be deleted.
+func route53RecordsOwned(resourceRecordSet *route53.ResourceRecordSet, zoneName, nameSuffix string) bool {
+	name := normalizeRoute53RecordsName(aws.StringValue(resourceRecordSet.Name))
+
+	if name == zoneName {
+		switch aws.StringValue(resourceRecordSet.Type) {
+		case route53.RRTypeNs, route53.RRTypeSoa:
+			return false
+		}
+	}
+
+	if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+		return false
+	}
+
+	if nameSuffix == "" {
+		return true
+	}
+
+	return name == nameSuffix || strings.HasSuffix(name, "."+nameSuffix)
+}
+
+// route53RecordsKey uniquely identifies a record within a hosted zone.
+type route53RecordsKey struct {
+	name          string
+	recordType    string
+	setIdentifier string
+}
+
+func newRoute53RecordsKey(resourceRecordSet *route53.ResourceRecordSet) route53RecordsKey {
+	return route53RecordsKey{
+		name:          normalizeRoute53RecordsName(aws.StringValue(resourceRecordSet.Name)),
+		recordType:    aws.StringValue(resourceRecordSet.Type),
+		setIdentifier: aws.StringValue(resourceRecordSet.SetIdentifier),
+	}
+}
+
+// route53RecordsRecordHash returns a set hash function for records that ignores differences
+// in alias target names which Route 53 treats as equivalent, e.g. case or a "dualstack." prefix.
+func route53RecordsRecordHash(recordResource *schema.Resource) schema.SchemaSetFunc {
+	f := schema.HashResource(recordResource)
+
+	return func(v interface{}) int {
+		tfMap := v.(map[string]interface{})
+
+		alias, ok := tfMap["alias"].([]interface{})
+
+		if !ok || len(alias) == 0 || alias[0] == nil {
+			return f(tfMap)
+		}
+
+		aliasMap := make(map[string]interface{})
+		for k, v := range alias[0].(map[string]interface{}) {
+			aliasMap[k] = v
+		}
+		aliasMap["name"] = normalizeAwsAliasName(aliasMap["name"])
+
+		m := make(map[string]interface{})
+		for k, v := range tfMap {
+			m[k] = v
+		}
+		m["alias"] = []interface{}{aliasMap}
+
+		return f(m)
+	}
+}
+
+func normalizeRoute53RecordsName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(cleanRecordName(name), "."))
+}
+
+func validateRoute53RecordsName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+	} else if value != strings.ToLower(strings.TrimSuffix(value, ".")) {
+		errors = append(errors, fmt.Errorf("%q must be a lowercase fully qualified domain name without a trailing period, got: %s", k, value))
+	}
+
+	return
+}
+
+func expandRoute53RecordsRecord(tfMap map[string]interface{}) (*route53.ResourceRecordSet, error) {
+	name := tfMap["name"].(string)
+	recordType := tfMap["type"].(string)
+
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(name),
+		Type: aws.String(recordType),
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(tfMap["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(tfMap["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(tfMap["zone_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRecords = expandResourceRecords(v.List(), recordType)
+	}
+
+	// A zero TTL is indistinguishable from an unset one, see the "ttl" validation.
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	if apiObject.AliasTarget != nil {
+		if apiObject.ResourceRecords != nil || apiObject.TTL != nil {
+			return nil, fmt.Errorf("record %s (%s): \"alias\" conflicts with \"records\" and \"ttl\"", name, recordType)
+		}
+	} else if apiObject.ResourceRecords == nil || apiObject.TTL == nil {
+		return nil, fmt.Errorf("record %s (%s): \"records\" and \"ttl\" are required when \"alias\" is not set", name, recordType)
+	}
+
+	if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+		apiObject.HealthCheckId = aws.String(v)
+	}
+
+	if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+		apiObject.SetIdentifier = aws.String(v)
+	}
+
+	var policies int
+
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Failover = aws.String(tfMap["type"].(string))
+		policies++
+	}
+
+	if v, ok := tfMap["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.GeoLocation = &route53.GeoLocation{
+			ContinentCode:   nilString(tfMap["continent"].(string)),
+			CountryCode:     nilString(tfMap["country"].(string)),
+			SubdivisionCode: nilString(tfMap["subdivision"].(string)),
+		}
+		policies++
+	}
+
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Region = aws.String(tfMap["region"].(string))
+		policies++
+	}
+
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		apiObject.MultiValueAnswer = aws.Bool(v)
+		policies++
+	}
+
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Weight = aws.Int64(int64(tfMap["weight"].(int)))
+		policies++
+	}
+
+	if policies > 1 {
+		return nil, fmt.Errorf("record %s (%s): only one routing policy may be specified", name, recordType)
+	}
+
+	if policies == 1 && apiObject.SetIdentifier == nil {
+		return nil, fmt.Errorf("record %s (%s): \"set_identifier\" is required when a routing policy is set", name, recordType)
+	}
+
+	return apiObject, nil
+}
+
+func flattenRoute53RecordsRecord(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	recordType := aws.StringValue(apiObject.Type)
+
+	tfMap := map[string]interface{}{
+		"alias":                            []interface{}{},
+		"failover_routing_policy":          []interface{}{},
+		"geolocation_routing_policy":       []interface{}{},
+		"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+		"latency_routing_policy":           []interface{}{},
+		"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                             normalizeRoute53RecordsName(aws.StringValue(apiObject.Name)),
+		"records":                          schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(flattenResourceRecords(apiObject.ResourceRecords, recordType)))),
+		"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+		"ttl":                              int(aws.Int64Value(apiObject.TTL)),
+		"type":                             recordType,
+		"weighted_routing_policy":          []interface{}{},
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{
+			map[string]interface{}{
+				"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+				"name":                   normalizeAwsAliasName(aws.StringValue(v.DNSName)),
+				"zone_id":                aws.StringValue(v.HostedZoneId),
+			},
+		}
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{
+			map[string]interface{}{
+				"type": aws.StringValue(v),
+			},
+		}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{
+			map[string]interface{}{
+				"continent":   aws.StringValue(v.ContinentCode),
+				"country":     aws.StringValue(v.CountryCode),
+				"subdivision": aws.StringValue(v.SubdivisionCode),
+			},
+		}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{
+			map[string]interface{}{
+				"region": aws.StringValue(v),
+			},
+		}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{
+			map[string]interface{}{
+				"weight": int(aws.Int64Value(v)),
+			},
+		}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,507 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/waiter"
+)
+
+func TestRoute53RecordsOwned(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Type       string
+		NameSuffix string
+		Expected   bool
+	}{
+		{"example.com.", route53.RRTypeSoa, "", false},
+		{"example.com.", route53.RRTypeNs, "", false},
+		{"example.com.", route53.RRTypeA, "", true},
+		{"sub.example.com.", route53.RRTypeNs, "", true},
+		{"www.example.com.", route53.RRTypeA, "", true},
+		{"WWW.Example.com", route53.RRTypeA, "", true},
+		{"\\052.example.com.", route53.RRTypeA, "", true},
+		{"www.example.org.", route53.RRTypeA, "", false},
+		{"www.notexample.com.", route53.RRTypeA, "", false},
+		{"www.dev.example.com.", route53.RRTypeA, "dev.example.com", true},
+		{"dev.example.com.", route53.RRTypeA, "dev.example.com", true},
+		{"www.example.com.", route53.RRTypeA, "dev.example.com", false},
+		{"www.notdev.example.com.", route53.RRTypeA, "dev.example.com", false},
+	}
+
+	for _, tc := range cases {
+		resourceRecordSet := &route53.ResourceRecordSet{
+			Name: aws.String(tc.Name),
+			Type: aws.String(tc.Type),
+		}
+
+		if got := route53RecordsOwned(resourceRecordSet, "example.com", tc.NameSuffix); got != tc.Expected {
+			t.Errorf("route53RecordsOwned(%s, %s, %q) = %t, expected %t", tc.Name, tc.Type, tc.NameSuffix, got, tc.Expected)
+		}
+	}
+}
+
+func TestRoute53RecordsKey(t *testing.T) {
+	// With a joined string key these two records would collide.
+	recordA := &route53.ResourceRecordSet{
+		Name:          aws.String("_dmarc.example.com."),
+		Type:          aws.String(route53.RRTypeTxt),
+		SetIdentifier: aws.String("a_TXT_b"),
+	}
+	recordB := &route53.ResourceRecordSet{
+		Name:          aws.String("_dmarc.example.com_TXT_a."),
+		Type:          aws.String(route53.RRTypeTxt),
+		SetIdentifier: aws.String("b"),
+	}
+
+	if newRoute53RecordsKey(recordA) == newRoute53RecordsKey(recordB) {
+		t.Errorf("expected different keys for %s and %s", recordA, recordB)
+	}
+
+	recordC := &route53.ResourceRecordSet{
+		Name: aws.String("WWW.Example.com."),
+		Type: aws.String(route53.RRTypeA),
+	}
+	recordD := &route53.ResourceRecordSet{
+		Name: aws.String("www.example.com"),
+		Type: aws.String(route53.RRTypeA),
+	}
+
+	if newRoute53RecordsKey(recordC) != newRoute53RecordsKey(recordD) {
+		t.Errorf("expected equal keys for %s and %s", recordC, recordD)
+	}
+}
+
+func TestRoute53RecordsRecordHash(t *testing.T) {
+	f := resourceAwsRoute53Records().Schema["record"].Set
+
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String("www.example.com."),
+		Type: aws.String(route53.RRTypeA),
+		AliasTarget: &route53.AliasTarget{
+			DNSName:              aws.String("dualstack.my-lb-123456789.us-west-2.elb.amazonaws.com."),
+			EvaluateTargetHealth: aws.Bool(true),
+			HostedZoneId:         aws.String("Z1H1FL5HABSF5"),
+		},
+	}
+
+	for _, name := range []string{
+		"my-lb-123456789.us-west-2.elb.amazonaws.com",
+		"dualstack.my-lb-123456789.us-west-2.elb.amazonaws.com",
+		"DUALSTACK.My-LB-123456789.us-west-2.elb.amazonaws.com.",
+	} {
+		tfMap := flattenRoute53RecordsRecord(apiObject)
+		tfMap["alias"] = []interface{}{
+			map[string]interface{}{
+				"evaluate_target_health": true,
+				"name":                   name,
+				"zone_id":                "Z1H1FL5HABSF5",
+			},
+		}
+
+		if got, expected := f(tfMap), f(flattenRoute53RecordsRecord(apiObject)); got != expected {
+			t.Errorf("expected alias name %q to hash the same as the API value", name)
+		}
+	}
+
+	tfMap := flattenRoute53RecordsRecord(apiObject)
+	tfMap["alias"] = []interface{}{
+		map[string]interface{}{
+			"evaluate_target_health": true,
+			"name":                   "other-lb-123456789.us-west-2.elb.amazonaws.com",
+			"zone_id":                "Z1H1FL5HABSF5",
+		},
+	}
+
+	if f(tfMap) == f(flattenRoute53RecordsRecord(apiObject)) {
+		t.Errorf("expected different alias names to hash differently")
+	}
+}
+
+func TestAccAWSRoute53Records_basic(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecordsConfigBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "name_suffix", ""),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName,
+						"type":      "A",
+						"ttl":       "300",
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "txt." + zoneName,
+						"type":      "TXT",
+						"ttl":       "60",
+						"records.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53Records_update(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecordsConfigBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 2),
+				),
+			},
+			{
+				Config: testAccAWSRoute53RecordsConfigUpdated(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName,
+						"type":      "A",
+						"ttl":       "600",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "mail." + zoneName,
+						"type":      "MX",
+						"ttl":       "300",
+						"records.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53Records_unmanagedRecord(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecordsConfigBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 2),
+					testAccCheckRoute53RecordsCreateUnmanaged(resourceName, "unmanaged."+zoneName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSRoute53RecordsConfigBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53Records_NameSuffix(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecordsConfigNameSuffix(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "name_suffix", "dev."+zoneName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "www.dev." + zoneName,
+						"type": "CNAME",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53Records_manyRecords(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecordsConfigMany(zoneName, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 1200),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1200"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53RecordsConfigMany(zoneName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsCount(resourceName, 10),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "10"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRoute53RecordsCount verifies the number of records in the hosted zone
+// that are owned by the resource.
+func testAccCheckRoute53RecordsCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Records ID is set")
+		}
+
+		zoneID, nameSuffix, err := tfroute53.RecordsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		zoneName, err := route53HostedZoneName(conn, zoneID)
+
+		if err != nil {
+			return err
+		}
+
+		resourceRecordSets, err := finder.ResourceRecordSets(conn, zoneID)
+
+		if err != nil {
+			return err
+		}
+
+		var count int
+
+		for _, resourceRecordSet := range resourceRecordSets {
+			if route53RecordsOwned(resourceRecordSet, zoneName, nameSuffix) {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("expected %d Route 53 Records (%s), got %d", expected, rs.Primary.ID, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRoute53RecordsCreateUnmanaged(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{
+					{
+						Action: aws.String(route53.ChangeActionCreate),
+						ResourceRecordSet: &route53.ResourceRecordSet{
+							Name:            aws.String(name),
+							ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("127.0.0.1")}},
+							TTL:             aws.Int64(30),
+							Type:            aws.String(route53.RRTypeA),
+						},
+					},
+				},
+			},
+			HostedZoneId: aws.String(rs.Primary.Attributes["zone_id"]),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(input)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.ChangeInfoStatusInsync(conn, cleanChangeID(aws.StringValue(output.ChangeInfo.Id)))
+
+		return err
+	}
+}
+
+func testAccCheckRoute53RecordsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_records" {
+			continue
+		}
+
+		zoneID, nameSuffix, err := tfroute53.RecordsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		zoneName, err := route53HostedZoneName(conn, zoneID)
+
+		if isAWSErr(err, route53.ErrCodeNoSuchHostedZone, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		resourceRecordSets, err := finder.ResourceRecordSets(conn, zoneID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, resourceRecordSet := range resourceRecordSets {
+			if route53RecordsOwned(resourceRecordSet, zoneName, nameSuffix) {
+				return fmt.Errorf("Route 53 Records (%s) still exist", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSRoute53RecordsConfigBasic(zoneName string) string {
+	return composeConfig(testAccRoute53ZoneConfig(zoneName), fmt.Sprintf(`
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["127.0.0.1", "127.0.0.2"]
+  }
+
+  record {
+    name    = "txt.%[1]s"
+    type    = "TXT"
+    ttl     = 60
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName))
+}
+
+func testAccAWSRoute53RecordsConfigUpdated(zoneName string) string {
+	return composeConfig(testAccRoute53ZoneConfig(zoneName), fmt.Sprintf(`
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 600
+    records = ["127.0.0.3"]
+  }
+
+  record {
+    name    = "mail.%[1]s"
+    type    = "MX"
+    ttl     = 300
+    records = ["10 mail.%[1]s"]
+  }
+}
+`, zoneName))
+}
+
+func testAccAWSRoute53RecordsConfigNameSuffix(zoneName string) string {
+	return composeConfig(testAccRoute53ZoneConfig(zoneName), fmt.Sprintf(`
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_records" "test" {
+  zone_id     = aws_route53_zone.test.zone_id
+  name_suffix = "dev.%[1]s"
+
+  record {
+    name    = "www.dev.%[1]s"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.%[1]s"]
+  }
+}
+`, zoneName))
+}
+
+func testAccAWSRoute53RecordsConfigMany(zoneName string, count int) string {
+	return composeConfig(testAccRoute53ZoneConfig(zoneName), fmt.Sprintf(`
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  dynamic "record" {
+    for_each = range(%[2]d)
+
+    content {
+      name    = "host${record.value}.%[1]s"
+      type    = "A"
+      ttl     = 300
+      records = ["10.0.${floor(record.value / 256)}.${record.value %% 256}"]
+    }
+  }
+}
+`, zoneName, count))
+}
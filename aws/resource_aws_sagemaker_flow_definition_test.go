@@ -0,0 +1,457 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
+		Name: "aws_sagemaker_flow_definition",
+		F:    testSweepSagemakerFlowDefinitions,
+	})
+}
+
+func testSweepSagemakerFlowDefinitions(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).sagemakerconn
+
+	err = conn.ListFlowDefinitionsPages(&sagemaker.ListFlowDefinitionsInput{}, func(page *sagemaker.ListFlowDefinitionsOutput, lastPage bool) bool {
+		for _, flowDefinition := range page.FlowDefinitionSummaries {
+			name := aws.StringValue(flowDefinition.FlowDefinitionName)
+
+			input := &sagemaker.DeleteFlowDefinitionInput{
+				FlowDefinitionName: flowDefinition.FlowDefinitionName,
+			}
+
+			log.Printf("[INFO] Deleting SageMaker Flow Definition: %s", name)
+			if _, err := conn.DeleteFlowDefinition(input); err != nil {
+				log.Printf("[ERROR] Error deleting SageMaker Flow Definition (%s): %s", name, err)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Flow Definition sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error retrieving SageMaker Flow Definitions: %w", err)
+	}
+
+	return nil
+}
+
+func testAccAWSSagemakerFlowDefinition_basic(t *testing.T) {
+	var flowDefinition sagemaker.DescribeFlowDefinitionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_flow_definition.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerFlowDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerFlowDefinitionBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerFlowDefinitionExists(resourceName, &flowDefinition),
+					resource.TestCheckResourceAttr(resourceName, "flow_definition_name", rName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("flow-definition/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "human_loop_config.0.human_task_ui_arn", "aws_sagemaker_human_task_ui.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_config.0.task_availability_lifetime_in_seconds", "1"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_config.0.task_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_config.0.task_description", rName),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_config.0.task_title", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "human_loop_config.0.workteam_arn", "aws_sagemaker_workteam.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_activation_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_request_source.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_output_path", fmt.Sprintf("s3://%s/", rName)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerFlowDefinition_HumanLoopConfigTaskKeywords(t *testing.T) {
+	var flowDefinition sagemaker.DescribeFlowDefinitionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_flow_definition.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerFlowDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerFlowDefinitionTaskKeywordsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerFlowDefinitionExists(resourceName, &flowDefinition),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_config.0.task_keywords.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "human_loop_config.0.task_keywords.*", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerFlowDefinition_HumanLoopRequestSource(t *testing.T) {
+	var flowDefinition sagemaker.DescribeFlowDefinitionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_flow_definition.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerFlowDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerFlowDefinitionHumanLoopRequestSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerFlowDefinitionExists(resourceName, &flowDefinition),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_request_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_request_source.0.aws_managed_human_loop_request_source", "AWS/Textract/AnalyzeDocument/Forms/V1"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_activation_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "human_loop_activation_config.0.human_loop_activation_conditions_config.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerFlowDefinition_tags(t *testing.T) {
+	var flowDefinition sagemaker.DescribeFlowDefinitionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_flow_definition.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerFlowDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerFlowDefinitionConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerFlowDefinitionExists(resourceName, &flowDefinition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSagemakerFlowDefinitionConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerFlowDefinitionExists(resourceName, &flowDefinition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerFlowDefinitionConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerFlowDefinitionExists(resourceName, &flowDefinition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerFlowDefinition_disappears(t *testing.T) {
+	var flowDefinition sagemaker.DescribeFlowDefinitionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_flow_definition.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerFlowDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerFlowDefinitionBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerFlowDefinitionExists(resourceName, &flowDefinition),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSagemakerFlowDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerFlowDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_flow_definition" {
+			continue
+		}
+
+		flowDefinition, err := finder.FlowDefinitionByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SageMaker Flow Definition (%s): %w", rs.Primary.ID, err)
+		}
+
+		if flowDefinition != nil && aws.StringValue(flowDefinition.FlowDefinitionName) == rs.Primary.ID {
+			return fmt.Errorf("SageMaker Flow Definition %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerFlowDefinitionExists(n string, flowDefinition *sagemaker.DescribeFlowDefinitionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Flow Definition ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		resp, err := finder.FlowDefinitionByName(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("SageMaker Flow Definition (%s) not found", rs.Primary.ID)
+		}
+
+		*flowDefinition = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerFlowDefinitionBaseConfig(rName string) string {
+	return composeConfig(testAccAWSSagemakerWorkteamCognitoConfig(rName), testAccAWSSagemakerHumanTaskUiBasicConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = ["s3:*"]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccAWSSagemakerFlowDefinitionBasicConfig(rName string) string {
+	return composeConfig(testAccAWSSagemakerFlowDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_flow_definition" "test" {
+  flow_definition_name = %[1]q
+  role_arn             = aws_iam_role.test.arn
+
+  human_loop_config {
+    human_task_ui_arn                     = aws_sagemaker_human_task_ui.test.arn
+    task_availability_lifetime_in_seconds = 1
+    task_count                            = 1
+    task_description                      = %[1]q
+    task_title                            = %[1]q
+    workteam_arn                          = aws_sagemaker_workteam.test.arn
+  }
+
+  output_config {
+    s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+  }
+}
+`, rName))
+}
+
+func testAccAWSSagemakerFlowDefinitionTaskKeywordsConfig(rName string) string {
+	return composeConfig(testAccAWSSagemakerFlowDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_flow_definition" "test" {
+  flow_definition_name = %[1]q
+  role_arn             = aws_iam_role.test.arn
+
+  human_loop_config {
+    human_task_ui_arn                     = aws_sagemaker_human_task_ui.test.arn
+    task_availability_lifetime_in_seconds = 1
+    task_count                            = 1
+    task_description                      = %[1]q
+    task_title                            = %[1]q
+    task_keywords                         = ["test"]
+    workteam_arn                          = aws_sagemaker_workteam.test.arn
+  }
+
+  output_config {
+    s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+  }
+}
+`, rName))
+}
+
+func testAccAWSSagemakerFlowDefinitionHumanLoopRequestSourceConfig(rName string) string {
+	return composeConfig(testAccAWSSagemakerFlowDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_flow_definition" "test" {
+  flow_definition_name = %[1]q
+  role_arn             = aws_iam_role.test.arn
+
+  human_loop_config {
+    human_task_ui_arn                     = aws_sagemaker_human_task_ui.test.arn
+    task_availability_lifetime_in_seconds = 1
+    task_count                            = 1
+    task_description                      = %[1]q
+    task_title                            = %[1]q
+    workteam_arn                          = aws_sagemaker_workteam.test.arn
+  }
+
+  human_loop_request_source {
+    aws_managed_human_loop_request_source = "AWS/Textract/AnalyzeDocument/Forms/V1"
+  }
+
+  human_loop_activation_config {
+    human_loop_activation_conditions_config {
+      human_loop_activation_conditions = jsonencode({
+        Conditions = [{
+          ConditionType = "Sampling"
+          ConditionParameters = {
+            RandomSamplingPercentage = 5
+          }
+        }]
+      })
+    }
+  }
+
+  output_config {
+    s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+  }
+}
+`, rName))
+}
+
+func testAccAWSSagemakerFlowDefinitionConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSSagemakerFlowDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_flow_definition" "test" {
+  flow_definition_name = %[1]q
+  role_arn             = aws_iam_role.test.arn
+
+  human_loop_config {
+    human_task_ui_arn                     = aws_sagemaker_human_task_ui.test.arn
+    task_availability_lifetime_in_seconds = 1
+    task_count                            = 1
+    task_description                      = %[1]q
+    task_title                            = %[1]q
+    workteam_arn                          = aws_sagemaker_workteam.test.arn
+  }
+
+  output_config {
+    s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSSagemakerFlowDefinitionConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSSagemakerFlowDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_flow_definition" "test" {
+  flow_definition_name = %[1]q
+  role_arn             = aws_iam_role.test.arn
+
+  human_loop_config {
+    human_task_ui_arn                     = aws_sagemaker_human_task_ui.test.arn
+    task_availability_lifetime_in_seconds = 1
+    task_count                            = 1
+    task_description                      = %[1]q
+    task_title                            = %[1]q
+    workteam_arn                          = aws_sagemaker_workteam.test.arn
+  }
+
+  output_config {
+    s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,259 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
+		Name: "aws_sagemaker_human_task_ui",
+		F:    testSweepSagemakerHumanTaskUis,
+		Dependencies: []string{
+			"aws_sagemaker_flow_definition",
+		},
+	})
+}
+
+func testSweepSagemakerHumanTaskUis(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).sagemakerconn
+
+	err = conn.ListHumanTaskUisPages(&sagemaker.ListHumanTaskUisInput{}, func(page *sagemaker.ListHumanTaskUisOutput, lastPage bool) bool {
+		for _, humanTaskUi := range page.HumanTaskUiSummaries {
+			name := aws.StringValue(humanTaskUi.HumanTaskUiName)
+
+			input := &sagemaker.DeleteHumanTaskUiInput{
+				HumanTaskUiName: humanTaskUi.HumanTaskUiName,
+			}
+
+			log.Printf("[INFO] Deleting SageMaker Human Task UI: %s", name)
+			if _, err := conn.DeleteHumanTaskUi(input); err != nil {
+				log.Printf("[ERROR] Error deleting SageMaker Human Task UI (%s): %s", name, err)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Human Task UI sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error retrieving SageMaker Human Task UIs: %w", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSSagemakerHumanTaskUi_basic(t *testing.T) {
+	var humanTaskUi sagemaker.DescribeHumanTaskUiOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_human_task_ui.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerHumanTaskUiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerHumanTaskUiBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerHumanTaskUiExists(resourceName, &humanTaskUi),
+					resource.TestCheckResourceAttr(resourceName, "human_task_ui_name", rName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("human-task-ui/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "ui_template.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "ui_template.0.content_sha256"),
+					resource.TestCheckResourceAttrSet(resourceName, "ui_template.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ui_template.0.content"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerHumanTaskUi_tags(t *testing.T) {
+	var humanTaskUi sagemaker.DescribeHumanTaskUiOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_human_task_ui.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerHumanTaskUiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerHumanTaskUiConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerHumanTaskUiExists(resourceName, &humanTaskUi),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ui_template.0.content"},
+			},
+			{
+				Config: testAccAWSSagemakerHumanTaskUiConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerHumanTaskUiExists(resourceName, &humanTaskUi),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerHumanTaskUiConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerHumanTaskUiExists(resourceName, &humanTaskUi),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerHumanTaskUi_disappears(t *testing.T) {
+	var humanTaskUi sagemaker.DescribeHumanTaskUiOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_human_task_ui.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerHumanTaskUiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerHumanTaskUiBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerHumanTaskUiExists(resourceName, &humanTaskUi),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSagemakerHumanTaskUi(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerHumanTaskUiDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_human_task_ui" {
+			continue
+		}
+
+		humanTaskUi, err := finder.HumanTaskUiByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SageMaker Human Task UI (%s): %w", rs.Primary.ID, err)
+		}
+
+		if humanTaskUi != nil && aws.StringValue(humanTaskUi.HumanTaskUiName) == rs.Primary.ID {
+			return fmt.Errorf("SageMaker Human Task UI %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerHumanTaskUiExists(n string, humanTaskUi *sagemaker.DescribeHumanTaskUiOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Human Task UI ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		resp, err := finder.HumanTaskUiByName(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("SageMaker Human Task UI (%s) not found", rs.Primary.ID)
+		}
+
+		*humanTaskUi = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerHumanTaskUiBasicConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_human_task_ui" "test" {
+  human_task_ui_name = %[1]q
+
+  ui_template {
+    content = file("test-fixtures/sagemaker-human-task-ui-tmpl.html")
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerHumanTaskUiConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_human_task_ui" "test" {
+  human_task_ui_name = %[1]q
+
+  ui_template {
+    content = file("test-fixtures/sagemaker-human-task-ui-tmpl.html")
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSSagemakerHumanTaskUiConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_human_task_ui" "test" {
+  human_task_ui_name = %[1]q
+
+  ui_template {
+    content = file("test-fixtures/sagemaker-human-task-ui-tmpl.html")
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAccAWSSagemaker_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"FlowDefinition": {
+			"basic":                       testAccAWSSagemakerFlowDefinition_basic,
+			"disappears":                  testAccAWSSagemakerFlowDefinition_disappears,
+			"HumanLoopConfigTaskKeywords": testAccAWSSagemakerFlowDefinition_HumanLoopConfigTaskKeywords,
+			"HumanLoopRequestSource":      testAccAWSSagemakerFlowDefinition_HumanLoopRequestSource,
+			"tags":                        testAccAWSSagemakerFlowDefinition_tags,
+		},
+		"Workforce": {
+			"disappears":     testAccAWSSagemakerWorkforce_disappears,
+			"CognitoConfig":  testAccAWSSagemakerWorkforce_cognitoConfig,
+			"OidcConfig":     testAccAWSSagemakerWorkforce_oidcConfig,
+			"SourceIpConfig": testAccAWSSagemakerWorkforce_sourceIpConfig,
+		},
+		"Workteam": {
+			"disappears":                testAccAWSSagemakerWorkteam_disappears,
+			"CognitoConfig":             testAccAWSSagemakerWorkteam_cognitoConfig,
+			"NotificationConfiguration": testAccAWSSagemakerWorkteam_notificationConfig,
+			"OidcConfig":                testAccAWSSagemakerWorkteam_oidcConfig,
+			"tags":                      testAccAWSSagemakerWorkteam_tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,1113 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub/finder"
+)
+
+func resourceAwsSecurityHubInsight() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubInsightCreate,
+		Read:   resourceAwsSecurityHubInsightRead,
+		Update: resourceAwsSecurityHubInsightUpdate,
+		Delete: resourceAwsSecurityHubInsightDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":               securityHubInsightStringFilterSchema(),
+						"company_name":                 securityHubInsightStringFilterSchema(),
+						"compliance_status":            securityHubInsightStringFilterSchema(),
+						"confidence":                   securityHubInsightNumberFilterSchema(),
+						"created_at":                   securityHubInsightDateFilterSchema(),
+						"criticality":                  securityHubInsightNumberFilterSchema(),
+						"description":                  securityHubInsightStringFilterSchema(),
+						"first_observed_at":            securityHubInsightDateFilterSchema(),
+						"generator_id":                 securityHubInsightStringFilterSchema(),
+						"id":                           securityHubInsightStringFilterSchema(),
+						"keyword":                      securityHubInsightKeywordFilterSchema(),
+						"last_observed_at":             securityHubInsightDateFilterSchema(),
+						"malware_name":                 securityHubInsightStringFilterSchema(),
+						"malware_path":                 securityHubInsightStringFilterSchema(),
+						"malware_state":                securityHubInsightStringFilterSchema(),
+						"malware_type":                 securityHubInsightStringFilterSchema(),
+						"network_destination_domain":   securityHubInsightStringFilterSchema(),
+						"network_destination_ipv4":     securityHubInsightIpFilterSchema(),
+						"network_destination_ipv6":     securityHubInsightIpFilterSchema(),
+						"network_destination_port":     securityHubInsightNumberFilterSchema(),
+						"network_direction":            securityHubInsightStringFilterSchema(),
+						"network_protocol":             securityHubInsightStringFilterSchema(),
+						"network_source_domain":        securityHubInsightStringFilterSchema(),
+						"network_source_ipv4":          securityHubInsightIpFilterSchema(),
+						"network_source_ipv6":          securityHubInsightIpFilterSchema(),
+						"network_source_mac":           securityHubInsightStringFilterSchema(),
+						"network_source_port":          securityHubInsightNumberFilterSchema(),
+						"note_text":                    securityHubInsightStringFilterSchema(),
+						"note_updated_at":              securityHubInsightDateFilterSchema(),
+						"note_updated_by":              securityHubInsightStringFilterSchema(),
+						"process_launched_at":          securityHubInsightDateFilterSchema(),
+						"process_name":                 securityHubInsightStringFilterSchema(),
+						"process_parent_pid":           securityHubInsightNumberFilterSchema(),
+						"process_path":                 securityHubInsightStringFilterSchema(),
+						"process_pid":                  securityHubInsightNumberFilterSchema(),
+						"process_terminated_at":        securityHubInsightDateFilterSchema(),
+						"product_arn":                  securityHubInsightStringFilterSchema(),
+						"product_fields":               securityHubInsightMapFilterSchema(),
+						"product_name":                 securityHubInsightStringFilterSchema(),
+						"recommendation_text":          securityHubInsightStringFilterSchema(),
+						"record_state":                 securityHubInsightStringFilterSchema(),
+						"related_findings_id":          securityHubInsightStringFilterSchema(),
+						"related_findings_product_arn": securityHubInsightStringFilterSchema(),
+						"resource_aws_ec2_instance_iam_instance_profile_arn": securityHubInsightStringFilterSchema(),
+						"resource_aws_ec2_instance_image_id":                 securityHubInsightStringFilterSchema(),
+						"resource_aws_ec2_instance_ipv4_addresses":           securityHubInsightIpFilterSchema(),
+						"resource_aws_ec2_instance_ipv6_addresses":           securityHubInsightIpFilterSchema(),
+						"resource_aws_ec2_instance_key_name":                 securityHubInsightStringFilterSchema(),
+						"resource_aws_ec2_instance_launched_at":              securityHubInsightDateFilterSchema(),
+						"resource_aws_ec2_instance_subnet_id":                securityHubInsightStringFilterSchema(),
+						"resource_aws_ec2_instance_type":                     securityHubInsightStringFilterSchema(),
+						"resource_aws_ec2_instance_vpc_id":                   securityHubInsightStringFilterSchema(),
+						"resource_aws_iam_access_key_created_at":             securityHubInsightDateFilterSchema(),
+						"resource_aws_iam_access_key_status":                 securityHubInsightStringFilterSchema(),
+						"resource_aws_iam_access_key_user_name":              securityHubInsightStringFilterSchema(),
+						"resource_aws_s3_bucket_owner_id":                    securityHubInsightStringFilterSchema(),
+						"resource_aws_s3_bucket_owner_name":                  securityHubInsightStringFilterSchema(),
+						"resource_container_image_id":                        securityHubInsightStringFilterSchema(),
+						"resource_container_image_name":                      securityHubInsightStringFilterSchema(),
+						"resource_container_launched_at":                     securityHubInsightDateFilterSchema(),
+						"resource_container_name":                            securityHubInsightStringFilterSchema(),
+						"resource_details_other":                             securityHubInsightMapFilterSchema(),
+						"resource_id":                                        securityHubInsightStringFilterSchema(),
+						"resource_partition":                                 securityHubInsightStringFilterSchema(),
+						"resource_region":                                    securityHubInsightStringFilterSchema(),
+						"resource_tags":                                      securityHubInsightMapFilterSchema(),
+						"resource_type":                                      securityHubInsightStringFilterSchema(),
+						"severity_label":                                     securityHubInsightStringFilterSchema(),
+						"severity_normalized":                                securityHubInsightNumberFilterSchema(),
+						"severity_product":                                   securityHubInsightNumberFilterSchema(),
+						"source_url":                                         securityHubInsightStringFilterSchema(),
+						"threat_intel_indicator_category":                    securityHubInsightStringFilterSchema(),
+						"threat_intel_indicator_last_observed_at":            securityHubInsightDateFilterSchema(),
+						"threat_intel_indicator_source":                      securityHubInsightStringFilterSchema(),
+						"threat_intel_indicator_source_url":                  securityHubInsightStringFilterSchema(),
+						"threat_intel_indicator_type":                        securityHubInsightStringFilterSchema(),
+						"threat_intel_indicator_value":                       securityHubInsightStringFilterSchema(),
+						"title":                                              securityHubInsightStringFilterSchema(),
+						"type":                                               securityHubInsightStringFilterSchema(),
+						"updated_at":                                         securityHubInsightDateFilterSchema(),
+						"user_defined_fields":                                securityHubInsightMapFilterSchema(),
+						"verification_state":                                 securityHubInsightStringFilterSchema(),
+						"workflow_state":                                     securityHubInsightStringFilterSchema(),
+						"workflow_status":                                    securityHubInsightStringFilterSchema(),
+					},
+				},
+			},
+			"group_by_attribute": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubInsightCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	name := d.Get("name").(string)
+
+	input := &securityhub.CreateInsightInput{
+		Filters:          expandSecurityHubSecurityFindingFilters(d.Get("filters").([]interface{})),
+		GroupByAttribute: aws.String(d.Get("group_by_attribute").(string)),
+		Name:             aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Insight: %s", input)
+	output, err := conn.CreateInsight(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Security Hub Insight (%s): %w", name, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating Security Hub Insight (%s): empty output", name)
+	}
+
+	d.SetId(aws.StringValue(output.InsightArn))
+
+	return resourceAwsSecurityHubInsightRead(d, meta)
+}
+
+func resourceAwsSecurityHubInsightRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	insight, err := finder.Insight(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Insight (%s): %w", d.Id(), err)
+	}
+
+	if insight == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Security Hub Insight (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", insight.InsightArn)
+	if err := d.Set("filters", flattenSecurityHubSecurityFindingFilters(insight.Filters)); err != nil {
+		return fmt.Errorf("error setting filters: %w", err)
+	}
+	d.Set("group_by_attribute", insight.GroupByAttribute)
+	d.Set("name", insight.Name)
+
+	return nil
+}
+
+func resourceAwsSecurityHubInsightUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateInsightInput{
+		InsightArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("filters") {
+		input.Filters = expandSecurityHubSecurityFindingFilters(d.Get("filters").([]interface{}))
+	}
+
+	if d.HasChange("group_by_attribute") {
+		input.GroupByAttribute = aws.String(d.Get("group_by_attribute").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Insight: %s", input)
+	_, err := conn.UpdateInsight(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Insight (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubInsightRead(d, meta)
+}
+
+func resourceAwsSecurityHubInsightDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Deleting Security Hub Insight: %s", d.Id())
+	_, err := conn.DeleteInsight(&securityhub.DeleteInsightInput{
+		InsightArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Hub Insight (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func securityHubInsightDateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"date_range": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"unit": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(securityhub.DateRangeUnit_Values(), false),
+							},
+							"value": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+						},
+					},
+				},
+				"end": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateUTCTimestamp,
+				},
+				"start": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateUTCTimestamp,
+				},
+			},
+		},
+	}
+}
+
+func securityHubInsightIpFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+		},
+	}
+}
+
+func securityHubInsightKeywordFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func securityHubInsightMapFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(securityhub.MapFilterComparison_Values(), false),
+				},
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func securityHubInsightNumberFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"eq": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTypeStringNullableFloat,
+				},
+				"gte": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTypeStringNullableFloat,
+				},
+				"lte": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTypeStringNullableFloat,
+				},
+			},
+		},
+	}
+}
+
+func securityHubInsightStringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(securityhub.StringFilterComparison_Values(), false),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandSecurityHubSecurityFindingFilters(tfList []interface{}) *securityhub.AwsSecurityFindingFilters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &securityhub.AwsSecurityFindingFilters{}
+
+	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AwsAccountId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["company_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CompanyName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["compliance_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComplianceStatus = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["confidence"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Confidence = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["created_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CreatedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["criticality"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Criticality = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["description"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Description = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FirstObservedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["generator_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.GeneratorId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Id = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["keyword"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Keyword = expandSecurityHubInsightKeywordFilters(v.List())
+	}
+
+	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LastObservedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["malware_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MalwareName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["malware_path"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MalwarePath = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["malware_state"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MalwareState = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["malware_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MalwareType = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_destination_domain"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkDestinationDomain = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_destination_ipv4"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkDestinationIpV4 = expandSecurityHubInsightIpFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_destination_ipv6"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkDestinationIpV6 = expandSecurityHubInsightIpFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_destination_port"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkDestinationPort = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_direction"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkDirection = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_protocol"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkProtocol = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_source_domain"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkSourceDomain = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_source_ipv4"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkSourceIpV4 = expandSecurityHubInsightIpFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_source_ipv6"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkSourceIpV6 = expandSecurityHubInsightIpFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_source_mac"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkSourceMac = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_source_port"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkSourcePort = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_text"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NoteText = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NoteUpdatedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_updated_by"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NoteUpdatedBy = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["process_launched_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProcessLaunchedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["process_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProcessName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["process_parent_pid"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProcessParentPid = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["process_path"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProcessPath = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["process_pid"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProcessPid = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["process_terminated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProcessTerminatedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["product_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProductArn = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["product_fields"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProductFields = expandSecurityHubInsightMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["product_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProductName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["recommendation_text"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RecommendationText = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["record_state"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RecordState = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_findings_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedFindingsId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_findings_product_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedFindingsProductArn = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_iam_instance_profile_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceIamInstanceProfileArn = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_image_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceImageId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_ipv4_addresses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceIpV4Addresses = expandSecurityHubInsightIpFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_ipv6_addresses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceIpV6Addresses = expandSecurityHubInsightIpFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_key_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceKeyName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_launched_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceLaunchedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_subnet_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceSubnetId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceType = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_ec2_instance_vpc_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsEc2InstanceVpcId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_iam_access_key_created_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsIamAccessKeyCreatedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_iam_access_key_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsIamAccessKeyStatus = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_iam_access_key_user_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsIamAccessKeyUserName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_s3_bucket_owner_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsS3BucketOwnerId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_aws_s3_bucket_owner_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceAwsS3BucketOwnerName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_container_image_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceContainerImageId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_container_image_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceContainerImageName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_container_launched_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceContainerLaunchedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_container_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceContainerName = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_details_other"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceDetailsOther = expandSecurityHubInsightMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceId = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_partition"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourcePartition = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_region"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRegion = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTags = expandSecurityHubInsightMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceType = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity_label"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SeverityLabel = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity_normalized"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SeverityNormalized = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity_product"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SeverityProduct = expandSecurityHubInsightNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["source_url"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SourceUrl = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["threat_intel_indicator_category"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ThreatIntelIndicatorCategory = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["threat_intel_indicator_last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ThreatIntelIndicatorLastObservedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["threat_intel_indicator_source"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ThreatIntelIndicatorSource = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["threat_intel_indicator_source_url"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ThreatIntelIndicatorSourceUrl = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["threat_intel_indicator_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ThreatIntelIndicatorType = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["threat_intel_indicator_value"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ThreatIntelIndicatorValue = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Title = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Type = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UpdatedAt = expandSecurityHubInsightDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["user_defined_fields"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UserDefinedFields = expandSecurityHubInsightMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["verification_state"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VerificationState = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["workflow_state"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.WorkflowState = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["workflow_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.WorkflowStatus = expandSecurityHubInsightStringFilters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandSecurityHubInsightDateFilters(tfList []interface{}) []*securityhub.DateFilter {
+	var apiObjects []*securityhub.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.DateFilter{}
+
+		if v, ok := tfMap["date_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfDateRange := v[0].(map[string]interface{})
+
+			apiObject.DateRange = &securityhub.DateRange{
+				Unit:  aws.String(tfDateRange["unit"].(string)),
+				Value: aws.Int64(int64(tfDateRange["value"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["end"].(string); ok && v != "" {
+			apiObject.End = aws.String(v)
+		}
+
+		if v, ok := tfMap["start"].(string); ok && v != "" {
+			apiObject.Start = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSecurityHubInsightIpFilters(tfList []interface{}) []*securityhub.IpFilter {
+	var apiObjects []*securityhub.IpFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &securityhub.IpFilter{
+			Cidr: aws.String(tfMap["cidr"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandSecurityHubInsightKeywordFilters(tfList []interface{}) []*securityhub.KeywordFilter {
+	var apiObjects []*securityhub.KeywordFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &securityhub.KeywordFilter{
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandSecurityHubInsightMapFilters(tfList []interface{}) []*securityhub.MapFilter {
+	var apiObjects []*securityhub.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &securityhub.MapFilter{
+			Comparison: aws.String(tfMap["comparison"].(string)),
+			Key:        aws.String(tfMap["key"].(string)),
+			Value:      aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandSecurityHubInsightNumberFilters(tfList []interface{}) []*securityhub.NumberFilter {
+	var apiObjects []*securityhub.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.NumberFilter{}
+
+		if v, ok := tfMap["eq"].(string); ok && v != "" {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				apiObject.Eq = aws.Float64(f)
+			}
+		}
+
+		if v, ok := tfMap["gte"].(string); ok && v != "" {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				apiObject.Gte = aws.Float64(f)
+			}
+		}
+
+		if v, ok := tfMap["lte"].(string); ok && v != "" {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				apiObject.Lte = aws.Float64(f)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSecurityHubInsightStringFilters(tfList []interface{}) []*securityhub.StringFilter {
+	var apiObjects []*securityhub.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &securityhub.StringFilter{
+			Comparison: aws.String(tfMap["comparison"].(string)),
+			Value:      aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSecurityHubSecurityFindingFilters(apiObject *securityhub.AwsSecurityFindingFilters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_account_id":               flattenSecurityHubInsightStringFilters(apiObject.AwsAccountId),
+		"company_name":                 flattenSecurityHubInsightStringFilters(apiObject.CompanyName),
+		"compliance_status":            flattenSecurityHubInsightStringFilters(apiObject.ComplianceStatus),
+		"confidence":                   flattenSecurityHubInsightNumberFilters(apiObject.Confidence),
+		"created_at":                   flattenSecurityHubInsightDateFilters(apiObject.CreatedAt),
+		"criticality":                  flattenSecurityHubInsightNumberFilters(apiObject.Criticality),
+		"description":                  flattenSecurityHubInsightStringFilters(apiObject.Description),
+		"first_observed_at":            flattenSecurityHubInsightDateFilters(apiObject.FirstObservedAt),
+		"generator_id":                 flattenSecurityHubInsightStringFilters(apiObject.GeneratorId),
+		"id":                           flattenSecurityHubInsightStringFilters(apiObject.Id),
+		"keyword":                      flattenSecurityHubInsightKeywordFilters(apiObject.Keyword),
+		"last_observed_at":             flattenSecurityHubInsightDateFilters(apiObject.LastObservedAt),
+		"malware_name":                 flattenSecurityHubInsightStringFilters(apiObject.MalwareName),
+		"malware_path":                 flattenSecurityHubInsightStringFilters(apiObject.MalwarePath),
+		"malware_state":                flattenSecurityHubInsightStringFilters(apiObject.MalwareState),
+		"malware_type":                 flattenSecurityHubInsightStringFilters(apiObject.MalwareType),
+		"network_destination_domain":   flattenSecurityHubInsightStringFilters(apiObject.NetworkDestinationDomain),
+		"network_destination_ipv4":     flattenSecurityHubInsightIpFilters(apiObject.NetworkDestinationIpV4),
+		"network_destination_ipv6":     flattenSecurityHubInsightIpFilters(apiObject.NetworkDestinationIpV6),
+		"network_destination_port":     flattenSecurityHubInsightNumberFilters(apiObject.NetworkDestinationPort),
+		"network_direction":            flattenSecurityHubInsightStringFilters(apiObject.NetworkDirection),
+		"network_protocol":             flattenSecurityHubInsightStringFilters(apiObject.NetworkProtocol),
+		"network_source_domain":        flattenSecurityHubInsightStringFilters(apiObject.NetworkSourceDomain),
+		"network_source_ipv4":          flattenSecurityHubInsightIpFilters(apiObject.NetworkSourceIpV4),
+		"network_source_ipv6":          flattenSecurityHubInsightIpFilters(apiObject.NetworkSourceIpV6),
+		"network_source_mac":           flattenSecurityHubInsightStringFilters(apiObject.NetworkSourceMac),
+		"network_source_port":          flattenSecurityHubInsightNumberFilters(apiObject.NetworkSourcePort),
+		"note_text":                    flattenSecurityHubInsightStringFilters(apiObject.NoteText),
+		"note_updated_at":              flattenSecurityHubInsightDateFilters(apiObject.NoteUpdatedAt),
+		"note_updated_by":              flattenSecurityHubInsightStringFilters(apiObject.NoteUpdatedBy),
+		"process_launched_at":          flattenSecurityHubInsightDateFilters(apiObject.ProcessLaunchedAt),
+		"process_name":                 flattenSecurityHubInsightStringFilters(apiObject.ProcessName),
+		"process_parent_pid":           flattenSecurityHubInsightNumberFilters(apiObject.ProcessParentPid),
+		"process_path":                 flattenSecurityHubInsightStringFilters(apiObject.ProcessPath),
+		"process_pid":                  flattenSecurityHubInsightNumberFilters(apiObject.ProcessPid),
+		"process_terminated_at":        flattenSecurityHubInsightDateFilters(apiObject.ProcessTerminatedAt),
+		"product_arn":                  flattenSecurityHubInsightStringFilters(apiObject.ProductArn),
+		"product_fields":               flattenSecurityHubInsightMapFilters(apiObject.ProductFields),
+		"product_name":                 flattenSecurityHubInsightStringFilters(apiObject.ProductName),
+		"recommendation_text":          flattenSecurityHubInsightStringFilters(apiObject.RecommendationText),
+		"record_state":                 flattenSecurityHubInsightStringFilters(apiObject.RecordState),
+		"related_findings_id":          flattenSecurityHubInsightStringFilters(apiObject.RelatedFindingsId),
+		"related_findings_product_arn": flattenSecurityHubInsightStringFilters(apiObject.RelatedFindingsProductArn),
+		"resource_aws_ec2_instance_iam_instance_profile_arn": flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsEc2InstanceIamInstanceProfileArn),
+		"resource_aws_ec2_instance_image_id":                 flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsEc2InstanceImageId),
+		"resource_aws_ec2_instance_ipv4_addresses":           flattenSecurityHubInsightIpFilters(apiObject.ResourceAwsEc2InstanceIpV4Addresses),
+		"resource_aws_ec2_instance_ipv6_addresses":           flattenSecurityHubInsightIpFilters(apiObject.ResourceAwsEc2InstanceIpV6Addresses),
+		"resource_aws_ec2_instance_key_name":                 flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsEc2InstanceKeyName),
+		"resource_aws_ec2_instance_launched_at":              flattenSecurityHubInsightDateFilters(apiObject.ResourceAwsEc2InstanceLaunchedAt),
+		"resource_aws_ec2_instance_subnet_id":                flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsEc2InstanceSubnetId),
+		"resource_aws_ec2_instance_type":                     flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsEc2InstanceType),
+		"resource_aws_ec2_instance_vpc_id":                   flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsEc2InstanceVpcId),
+		"resource_aws_iam_access_key_created_at":             flattenSecurityHubInsightDateFilters(apiObject.ResourceAwsIamAccessKeyCreatedAt),
+		"resource_aws_iam_access_key_status":                 flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsIamAccessKeyStatus),
+		"resource_aws_iam_access_key_user_name":              flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsIamAccessKeyUserName),
+		"resource_aws_s3_bucket_owner_id":                    flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsS3BucketOwnerId),
+		"resource_aws_s3_bucket_owner_name":                  flattenSecurityHubInsightStringFilters(apiObject.ResourceAwsS3BucketOwnerName),
+		"resource_container_image_id":                        flattenSecurityHubInsightStringFilters(apiObject.ResourceContainerImageId),
+		"resource_container_image_name":                      flattenSecurityHubInsightStringFilters(apiObject.ResourceContainerImageName),
+		"resource_container_launched_at":                     flattenSecurityHubInsightDateFilters(apiObject.ResourceContainerLaunchedAt),
+		"resource_container_name":                            flattenSecurityHubInsightStringFilters(apiObject.ResourceContainerName),
+		"resource_details_other":                             flattenSecurityHubInsightMapFilters(apiObject.ResourceDetailsOther),
+		"resource_id":                                        flattenSecurityHubInsightStringFilters(apiObject.ResourceId),
+		"resource_partition":                                 flattenSecurityHubInsightStringFilters(apiObject.ResourcePartition),
+		"resource_region":                                    flattenSecurityHubInsightStringFilters(apiObject.ResourceRegion),
+		"resource_tags":                                      flattenSecurityHubInsightMapFilters(apiObject.ResourceTags),
+		"resource_type":                                      flattenSecurityHubInsightStringFilters(apiObject.ResourceType),
+		"severity_label":                                     flattenSecurityHubInsightStringFilters(apiObject.SeverityLabel),
+		"severity_normalized":                                flattenSecurityHubInsightNumberFilters(apiObject.SeverityNormalized),
+		"severity_product":                                   flattenSecurityHubInsightNumberFilters(apiObject.SeverityProduct),
+		"source_url":                                         flattenSecurityHubInsightStringFilters(apiObject.SourceUrl),
+		"threat_intel_indicator_category":                    flattenSecurityHubInsightStringFilters(apiObject.ThreatIntelIndicatorCategory),
+		"threat_intel_indicator_last_observed_at":            flattenSecurityHubInsightDateFilters(apiObject.ThreatIntelIndicatorLastObservedAt),
+		"threat_intel_indicator_source":                      flattenSecurityHubInsightStringFilters(apiObject.ThreatIntelIndicatorSource),
+		"threat_intel_indicator_source_url":                  flattenSecurityHubInsightStringFilters(apiObject.ThreatIntelIndicatorSourceUrl),
+		"threat_intel_indicator_type":                        flattenSecurityHubInsightStringFilters(apiObject.ThreatIntelIndicatorType),
+		"threat_intel_indicator_value":                       flattenSecurityHubInsightStringFilters(apiObject.ThreatIntelIndicatorValue),
+		"title":                                              flattenSecurityHubInsightStringFilters(apiObject.Title),
+		"type":                                               flattenSecurityHubInsightStringFilters(apiObject.Type),
+		"updated_at":                                         flattenSecurityHubInsightDateFilters(apiObject.UpdatedAt),
+		"user_defined_fields":                                flattenSecurityHubInsightMapFilters(apiObject.UserDefinedFields),
+		"verification_state":                                 flattenSecurityHubInsightStringFilters(apiObject.VerificationState),
+		"workflow_state":                                     flattenSecurityHubInsightStringFilters(apiObject.WorkflowState),
+		"workflow_status":                                    flattenSecurityHubInsightStringFilters(apiObject.WorkflowStatus),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityHubInsightDateFilters(apiObjects []*securityhub.DateFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"end":   aws.StringValue(apiObject.End),
+			"start": aws.StringValue(apiObject.Start),
+		}
+
+		if v := apiObject.DateRange; v != nil {
+			tfMap["date_range"] = []interface{}{
+				map[string]interface{}{
+					"unit":  aws.StringValue(v.Unit),
+					"value": int(aws.Int64Value(v.Value)),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSecurityHubInsightIpFilters(apiObjects []*securityhub.IpFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cidr": aws.StringValue(apiObject.Cidr),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSecurityHubInsightKeywordFilters(apiObjects []*securityhub.KeywordFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSecurityHubInsightMapFilters(apiObjects []*securityhub.MapFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"comparison": aws.StringValue(apiObject.Comparison),
+			"key":        aws.StringValue(apiObject.Key),
+			"value":      aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSecurityHubInsightNumberFilters(apiObjects []*securityhub.NumberFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if apiObject.Eq != nil {
+			tfMap["eq"] = strconv.FormatFloat(aws.Float64Value(apiObject.Eq), 'f', -1, 64)
+		}
+
+		if apiObject.Gte != nil {
+			tfMap["gte"] = strconv.FormatFloat(aws.Float64Value(apiObject.Gte), 'f', -1, 64)
+		}
+
+		if apiObject.Lte != nil {
+			tfMap["lte"] = strconv.FormatFloat(aws.Float64Value(apiObject.Lte), 'f', -1, 64)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSecurityHubInsightStringFilters(apiObjects []*securityhub.StringFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"comparison": aws.StringValue(apiObject.Comparison),
+			"value":      aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,381 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub/finder"
+)
+
+func testAccAwsSecurityHubInsight_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "securityhub", regexp.MustCompile(`insight/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.aws_account_id.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filters.0.aws_account_id.*", map[string]string{
+						"comparison": securityhub.StringFilterComparisonEquals,
+						"value":      "1234567890",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "group_by_attribute", "AwsAccountId"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubInsight_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSecurityHubInsight(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubInsight_DateFilters(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigDateFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.created_at.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filters.0.created_at.*", map[string]string{
+						"date_range.#":       "1",
+						"date_range.0.unit":  securityhub.DateRangeUnitDays,
+						"date_range.0.value": "5",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubInsight_IpFilters(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigIpFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.network_destination_ipv4.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filters.0.network_destination_ipv4.*", map[string]string{
+						"cidr": "10.0.0.0/16",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubInsight_MapFilters(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigMapFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.product_fields.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filters.0.product_fields.*", map[string]string{
+						"comparison": securityhub.MapFilterComparisonEquals,
+						"key":        "key1",
+						"value":      "value1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubInsight_NumberFilters(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigNumberFilters(rName, "eq", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.confidence.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filters.0.confidence.*", map[string]string{
+						"eq": "3",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSecurityHubInsightConfigNumberFilters(rName, "gte", "5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.confidence.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filters.0.confidence.*", map[string]string{
+						"gte": "5",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubInsight_Name(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigBasic(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+				),
+			},
+			{
+				Config: testAccAwsSecurityHubInsightConfigBasic(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecurityHubInsightExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Insight ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		insight, err := finder.Insight(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if insight == nil {
+			return fmt.Errorf("Security Hub Insight (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsSecurityHubInsightDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_insight" {
+			continue
+		}
+
+		insight, err := finder.Insight(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		// The Security Hub account is disabled before the insight check runs
+		if tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if insight != nil {
+			return fmt.Errorf("Security Hub Insight (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsSecurityHubInsightConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_insight" "test" {
+  depends_on = [aws_securityhub_account.test]
+
+  filters {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  group_by_attribute = "AwsAccountId"
+  name               = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsSecurityHubInsightConfigDateFilters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_insight" "test" {
+  depends_on = [aws_securityhub_account.test]
+
+  filters {
+    created_at {
+      date_range {
+        unit  = "DAYS"
+        value = 5
+      }
+    }
+  }
+
+  group_by_attribute = "AwsAccountId"
+  name               = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsSecurityHubInsightConfigIpFilters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_insight" "test" {
+  depends_on = [aws_securityhub_account.test]
+
+  filters {
+    network_destination_ipv4 {
+      cidr = "10.0.0.0/16"
+    }
+  }
+
+  group_by_attribute = "AwsAccountId"
+  name               = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsSecurityHubInsightConfigMapFilters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_insight" "test" {
+  depends_on = [aws_securityhub_account.test]
+
+  filters {
+    product_fields {
+      comparison = "EQUALS"
+      key        = "key1"
+      value      = "value1"
+    }
+  }
+
+  group_by_attribute = "AwsAccountId"
+  name               = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsSecurityHubInsightConfigNumberFilters(rName, operator, value string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_insight" "test" {
+  depends_on = [aws_securityhub_account.test]
+
+  filters {
+    confidence {
+      %[2]s = %[3]q
+    }
+  }
+
+  group_by_attribute = "AwsAccountId"
+  name               = %[1]q
+}
+`, rName, operator, value)
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsSecurityHubOrganizationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_securityhub_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: nil, //lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubOrganizationConfigurationConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsSecurityHubOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSecurityHubOrganizationConfigurationConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsSecurityHubOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubOrganizationConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		_, err := conn.DescribeOrganizationConfiguration(&securityhub.DescribeOrganizationConfigurationInput{})
+
+		return err
+	}
+}
+
+func testAccAwsSecurityHubOrganizationConfigurationConfig(autoEnable bool) string {
+	return composeConfig(
+		testAccSecurityHubOrganizationAdminAccountConfigSelf(),
+		fmt.Sprintf(`
+resource "aws_securityhub_organization_configuration" "test" {
+  auto_enable = %[1]t
+
+  depends_on = [aws_securityhub_organization_admin_account.test]
+}
+`, autoEnable))
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfsecurityhub "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub/finder"
+)
+
+func resourceAwsSecurityHubStandardsControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubStandardsControlPut,
+		Read:   resourceAwsSecurityHubStandardsControlRead,
+		Update: resourceAwsSecurityHubStandardsControlPut,
+		Delete: resourceAwsSecurityHubStandardsControlDelete,
+
+		Schema: map[string]*schema.Schema{
+			"control_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(securityhub.ControlStatus_Values(), false),
+			},
+			"control_status_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"related_requirements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"remediation_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"severity_rating": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"standards_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubStandardsControlPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	arn := d.Get("standards_control_arn").(string)
+	controlStatus := d.Get("control_status").(string)
+
+	input := &securityhub.UpdateStandardsControlInput{
+		ControlStatus:       aws.String(controlStatus),
+		StandardsControlArn: aws.String(arn),
+	}
+
+	if controlStatus == securityhub.ControlStatusDisabled {
+		v, ok := d.GetOk("disabled_reason")
+
+		if !ok {
+			return fmt.Errorf("disabled_reason must be set when control_status is %s", securityhub.ControlStatusDisabled)
+		}
+
+		input.DisabledReason = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Standards Control: %s", input)
+	_, err := conn.UpdateStandardsControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Standards Control (%s): %w", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return resourceAwsSecurityHubStandardsControlRead(d, meta)
+}
+
+func resourceAwsSecurityHubStandardsControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	standardsSubscriptionARN, err := tfsecurityhub.StandardsControlARNToStandardsSubscriptionARN(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	control, err := finder.StandardsControl(conn, standardsSubscriptionARN, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Standards Control (%s): %w", d.Id(), err)
+	}
+
+	if control == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Security Hub Standards Control (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Security Hub Standards Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("control_id", control.ControlId)
+	d.Set("control_status", control.ControlStatus)
+	d.Set("control_status_updated_at", aws.TimeValue(control.ControlStatusUpdatedAt).Format(time.RFC3339))
+	d.Set("description", control.Description)
+	d.Set("disabled_reason", control.DisabledReason)
+	d.Set("related_requirements", aws.StringValueSlice(control.RelatedRequirements))
+	d.Set("remediation_url", control.RemediationUrl)
+	d.Set("severity_rating", control.SeverityRating)
+	d.Set("standards_control_arn", control.StandardsControlArn)
+	d.Set("title", control.Title)
+
+	return nil
+}
+
+func resourceAwsSecurityHubStandardsControlDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot delete Security Hub Standards Control (%s). Terraform will remove this resource from the state file, however the control status will remain unchanged.", d.Id())
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfsecurityhub "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub/finder"
+)
+
+func testAccAWSSecurityHubStandardsControl_basic(t *testing.T) {
+	var standardsControl securityhub.StandardsControl
+	resourceName := "aws_securityhub_standards_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil, // lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubStandardsControlConfigBasic(securityhub.ControlStatusEnabled, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubStandardsControlExists(resourceName, &standardsControl),
+					resource.TestCheckResourceAttr(resourceName, "control_id", "CIS.1.10"),
+					resource.TestCheckResourceAttr(resourceName, "control_status", securityhub.ControlStatusEnabled),
+					resource.TestCheckResourceAttrSet(resourceName, "control_status_updated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "related_requirements.0", "CIS AWS Foundations 1.10"),
+					resource.TestCheckResourceAttrSet(resourceName, "remediation_url"),
+					resource.TestCheckResourceAttr(resourceName, "severity_rating", "LOW"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Ensure IAM password policy prevents password reuse"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubStandardsControl_disabledControlStatus(t *testing.T) {
+	var standardsControl securityhub.StandardsControl
+	resourceName := "aws_securityhub_standards_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil, // lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubStandardsControlConfigBasic(securityhub.ControlStatusDisabled, "We handle password policies within Okta"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubStandardsControlExists(resourceName, &standardsControl),
+					resource.TestCheckResourceAttr(resourceName, "control_status", securityhub.ControlStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "disabled_reason", "We handle password policies within Okta"),
+				),
+			},
+			{
+				Config: testAccAWSSecurityHubStandardsControlConfigBasic(securityhub.ControlStatusEnabled, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubStandardsControlExists(resourceName, &standardsControl),
+					resource.TestCheckResourceAttr(resourceName, "control_status", securityhub.ControlStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubStandardsControl_enabledControlStatusAndDisabledReason(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil, // lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecurityHubStandardsControlConfigBasic(securityhub.ControlStatusDisabled, ""),
+				ExpectError: regexp.MustCompile("disabled_reason must be set when control_status is DISABLED"),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubStandardsControlExists(n string, control *securityhub.StandardsControl) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Standards Control ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		standardsSubscriptionARN, err := tfsecurityhub.StandardsControlARNToStandardsSubscriptionARN(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.StandardsControl(conn, standardsSubscriptionARN, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Security Hub Standards Control (%s) not found", rs.Primary.ID)
+		}
+
+		*control = *output
+
+		return nil
+	}
+}
+
+func testAccAWSSecurityHubStandardsControlConfigBasic(controlStatus, disabledReason string) string {
+	return composeConfig(
+		testAccAWSSecurityHubStandardsSubscriptionConfig_basic,
+		fmt.Sprintf(`
+resource "aws_securityhub_standards_control" "test" {
+  standards_control_arn = format("%%s/1.10", replace(aws_securityhub_standards_subscription.example.id, "subscription", "control"))
+  control_status        = %[1]q
+  disabled_reason       = %[2]q
+}
+`, controlStatus, disabledReason))
+}
@@ -9,6 +9,15 @@ func TestAccAWSSecurityHub_serial(t *testing.T) {
 		"Account": {
 			"basic": testAccAWSSecurityHubAccount_basic,
 		},
+		"Insight": {
+			"basic":         testAccAwsSecurityHubInsight_basic,
+			"disappears":    testAccAwsSecurityHubInsight_disappears,
+			"DateFilters":   testAccAwsSecurityHubInsight_DateFilters,
+			"IpFilters":     testAccAwsSecurityHubInsight_IpFilters,
+			"MapFilters":    testAccAwsSecurityHubInsight_MapFilters,
+			"NumberFilters": testAccAwsSecurityHubInsight_NumberFilters,
+			"Name":          testAccAwsSecurityHubInsight_Name,
+		},
 		"Member": {
 			"basic":  testAccAWSSecurityHubMember_basic,
 			"invite": testAccAWSSecurityHubMember_invite,
@@ -23,9 +32,17 @@ func TestAccAWSSecurityHub_serial(t *testing.T) {
 			"basic":      testAccAwsSecurityHubOrganizationAdminAccount_basic,
 			"disappears": testAccAwsSecurityHubOrganizationAdminAccount_disappears,
 		},
+		"OrganizationConfiguration": {
+			"basic": testAccAwsSecurityHubOrganizationConfiguration_basic,
+		},
 		"ProductSubscription": {
 			"basic": testAccAWSSecurityHubProductSubscription_basic,
 		},
+		"StandardsControl": {
+			"basic":                                 testAccAWSSecurityHubStandardsControl_basic,
+			"DisabledControlStatus":                 testAccAWSSecurityHubStandardsControl_disabledControlStatus,
+			"EnabledControlStatusAndDisabledReason": testAccAWSSecurityHubStandardsControl_enabledControlStatusAndDisabledReason,
+		},
 		"StandardsSubscription": {
 			"basic": testAccAWSSecurityHubStandardsSubscription_basic,
 		},
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func init() {
@@ -100,6 +101,8 @@ func TestAccAWSSNSTopic_basic(t *testing.T) {
 				Config: testAccAWSSNSTopicConfig_withGeneratedName,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					naming.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
 				),
 			},
 			{
@@ -140,7 +143,6 @@ func TestAccAWSSNSTopic_name(t *testing.T) {
 func TestAccAWSSNSTopic_namePrefix(t *testing.T) {
 	attributes := make(map[string]string)
 	resourceName := "aws_sns_topic.test"
-	startsWithPrefix := regexp.MustCompile("^terraform-test-topic-")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
@@ -152,14 +154,14 @@ func TestAccAWSSNSTopic_namePrefix(t *testing.T) {
 				Config: testAccAWSSNSTopicConfig_withNamePrefix(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSSNSTopicExists(resourceName, attributes),
-					resource.TestMatchResourceAttr(resourceName, "name", startsWithPrefix),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "terraform-test-topic-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-test-topic-"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"name_prefix"},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -38,6 +38,12 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// Desired instance state is only managed by aws_instance
+			s["instance_state"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 var sqsQueueAttributeMap = map[string]string{
@@ -37,6 +38,8 @@ var sqsQueueAttributeMap = map[string]string{
 // A number of these are marked as computed because if you don't
 // provide a value, SQS will provide you with defaults (which are the
 // default values specified below)
+const sqsFifoQueueNameSuffix = ".fifo"
+
 func resourceAwsSqsQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSqsQueueCreate,
@@ -59,6 +62,7 @@ func resourceAwsSqsQueue() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 			},
@@ -135,19 +139,12 @@ func resourceAwsSqsQueue() *schema.Resource {
 func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	sqsconn := meta.(*AWSClient).sqsconn
 
-	var name string
-
 	fq := d.Get("fifo_queue").(bool)
 
-	if v, ok := d.GetOk("name"); ok {
-		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
-		if fq {
-			name += ".fifo"
-		}
-	} else {
-		name = resource.UniqueId()
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+
+	if fq && d.Get("name").(string) == "" {
+		name += sqsFifoQueueNameSuffix
 	}
 
 	cbd := d.Get("content_based_deduplication").(bool)
@@ -311,6 +308,7 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("max_message_size", 262144)
 	d.Set("message_retention_seconds", 345600)
 	d.Set("name", name)
+	d.Set("name_prefix", naming.NamePrefixFromName(strings.TrimSuffix(name, sqsFifoQueueNameSuffix)))
 	d.Set("policy", "")
 	d.Set("receive_wait_time_seconds", 0)
 	d.Set("redrive_policy", "")
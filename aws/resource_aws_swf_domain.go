@@ -7,9 +7,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/swf"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 func resourceAwsSwfDomain() *schema.Resource {
@@ -33,6 +33,7 @@ func resourceAwsSwfDomain() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 			},
@@ -66,15 +67,7 @@ func resourceAwsSwfDomain() *schema.Resource {
 func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).swfconn
 
-	var name string
-
-	if v, ok := d.GetOk("name"); ok {
-		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
-	} else {
-		name = resource.UniqueId()
-	}
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	input := &swf.RegisterDomainInput{
 		Name:                                   aws.String(name),
@@ -133,6 +126,7 @@ func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("arn", resp.DomainInfo.Arn)
 	d.Set("name", resp.DomainInfo.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(resp.DomainInfo.Name)))
 	d.Set("description", resp.DomainInfo.Description)
 	d.Set("workflow_execution_retention_period_in_days", resp.Configuration.WorkflowExecutionRetentionPeriodInDays)
 
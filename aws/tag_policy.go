@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const (
+	tagPolicyComplianceDisabled = "disabled"
+	tagPolicyComplianceError    = "error"
+	tagPolicyComplianceWarning  = "warning"
+)
+
+func tagPolicyComplianceValues() []string {
+	return []string{
+		tagPolicyComplianceDisabled,
+		tagPolicyComplianceError,
+		tagPolicyComplianceWarning,
+	}
+}
+
+// getEffectiveTagPolicy returns the AWS Organizations tag policy in effect for
+// the caller's account, or nil if the account is not subject to a tag policy.
+func getEffectiveTagPolicy(conn *organizations.Organizations) (keyvaluetags.TagPolicy, error) {
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: aws.String(organizations.EffectivePolicyTypeTagPolicy),
+	}
+
+	output, err := conn.DescribeEffectivePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) || tfawserr.ErrCodeEquals(err, organizations.ErrCodeEffectivePolicyNotFoundException) {
+		log.Printf("[INFO] No effective tag policy found, skipping tag policy validation")
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error describing effective tag policy: %w", err)
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	tagPolicy, err := keyvaluetags.NewTagPolicy(aws.StringValue(output.EffectivePolicy.PolicyContent))
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading effective tag policy: %w", err)
+	}
+
+	return tagPolicy, nil
+}
+
+// addTagPolicyCustomizeDiff adds tag policy validation to every resource with
+// a configurable tags map.
+func addTagPolicyCustomizeDiff(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		v, ok := r.Schema["tags"]
+
+		if !ok || v.Type != schema.TypeMap || (!v.Optional && !v.Required) {
+			continue
+		}
+
+		if r.CustomizeDiff == nil {
+			r.CustomizeDiff = resourceTagPolicyCustomizeDiff
+		} else {
+			r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, resourceTagPolicyCustomizeDiff)
+		}
+	}
+}
+
+// resourceTagPolicyCustomizeDiff validates new or changed resource tags against
+// the effective tag policy. Tags ignored by the provider configuration and
+// AWS reserved tags are not validated.
+func resourceTagPolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*AWSClient)
+
+	if !ok || client.TagPolicy == nil {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("tags") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	tags := keyvaluetags.New(diff.Get("tags").(map[string]interface{})).IgnoreAws().IgnoreConfig(client.IgnoreTagsConfig)
+	violations := client.TagPolicy.Violations(tags)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	switch client.TagPolicyCompliance {
+	case tagPolicyComplianceError:
+		return fmt.Errorf("tags do not comply with the effective tag policy:\n%s", strings.Join(violations, "\n"))
+	case tagPolicyComplianceWarning:
+		for _, violation := range violations {
+			log.Printf("[WARN] Tags do not comply with the effective tag policy: %s", violation)
+		}
+	}
+
+	return nil
+}